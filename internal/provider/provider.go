@@ -532,83 +532,85 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_dynamodb_table":      dynamodb.DataSourceTable(),
 			"aws_dynamodb_table_item": dynamodb.DataSourceTableItem(),
 
-			"aws_ami":                                        ec2.DataSourceAMI(),
-			"aws_ami_ids":                                    ec2.DataSourceAMIIDs(),
-			"aws_availability_zone":                          ec2.DataSourceAvailabilityZone(),
-			"aws_availability_zones":                         ec2.DataSourceAvailabilityZones(),
-			"aws_customer_gateway":                           ec2.DataSourceCustomerGateway(),
-			"aws_ebs_default_kms_key":                        ec2.DataSourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                  ec2.DataSourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                               ec2.DataSourceEBSSnapshot(),
-			"aws_ebs_snapshot_ids":                           ec2.DataSourceEBSSnapshotIDs(),
-			"aws_ebs_volume":                                 ec2.DataSourceEBSVolume(),
-			"aws_ebs_volumes":                                ec2.DataSourceEBSVolumes(),
-			"aws_ec2_client_vpn_endpoint":                    ec2.DataSourceClientVPNEndpoint(),
-			"aws_ec2_coip_pool":                              ec2.DataSourceCoIPPool(),
-			"aws_ec2_coip_pools":                             ec2.DataSourceCoIPPools(),
-			"aws_ec2_host":                                   ec2.DataSourceHost(),
-			"aws_ec2_instance_type_offering":                 ec2.DataSourceInstanceTypeOffering(),
-			"aws_ec2_instance_type_offerings":                ec2.DataSourceInstanceTypeOfferings(),
-			"aws_ec2_instance_type":                          ec2.DataSourceInstanceType(),
-			"aws_ec2_instance_types":                         ec2.DataSourceInstanceTypes(),
-			"aws_ec2_local_gateway_route_table":              ec2.DataSourceLocalGatewayRouteTable(),
-			"aws_ec2_local_gateway_route_tables":             ec2.DataSourceLocalGatewayRouteTables(),
-			"aws_ec2_local_gateway_virtual_interface":        ec2.DataSourceLocalGatewayVirtualInterface(),
-			"aws_ec2_local_gateway_virtual_interface_group":  ec2.DataSourceLocalGatewayVirtualInterfaceGroup(),
-			"aws_ec2_local_gateway_virtual_interface_groups": ec2.DataSourceLocalGatewayVirtualInterfaceGroups(),
-			"aws_ec2_local_gateway":                          ec2.DataSourceLocalGateway(),
-			"aws_ec2_local_gateways":                         ec2.DataSourceLocalGateways(),
-			"aws_ec2_managed_prefix_list":                    ec2.DataSourceManagedPrefixList(),
-			"aws_ec2_managed_prefix_lists":                   ec2.DataSourceManagedPrefixLists(),
-			"aws_ec2_network_insights_analysis":              ec2.DataSourceNetworkInsightsAnalysis(),
-			"aws_ec2_network_insights_path":                  ec2.DataSourceNetworkInsightsPath(),
-			"aws_ec2_serial_console_access":                  ec2.DataSourceSerialConsoleAccess(),
-			"aws_ec2_spot_price":                             ec2.DataSourceSpotPrice(),
-			"aws_ec2_transit_gateway":                        ec2.DataSourceTransitGateway(),
-			"aws_ec2_transit_gateway_attachment":             ec2.DataSourceTransitGatewayAttachment(),
-			"aws_ec2_transit_gateway_connect":                ec2.DataSourceTransitGatewayConnect(),
-			"aws_ec2_transit_gateway_connect_peer":           ec2.DataSourceTransitGatewayConnectPeer(),
-			"aws_ec2_transit_gateway_dx_gateway_attachment":  ec2.DataSourceTransitGatewayDxGatewayAttachment(),
-			"aws_ec2_transit_gateway_multicast_domain":       ec2.DataSourceTransitGatewayMulticastDomain(),
-			"aws_ec2_transit_gateway_peering_attachment":     ec2.DataSourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_route_table":            ec2.DataSourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_tables":           ec2.DataSourceTransitGatewayRouteTables(),
-			"aws_ec2_transit_gateway_vpc_attachment":         ec2.DataSourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachments":        ec2.DataSourceTransitGatewayVPCAttachments(),
-			"aws_ec2_transit_gateway_vpn_attachment":         ec2.DataSourceTransitGatewayVPNAttachment(),
-			"aws_eip":                                        ec2.DataSourceEIP(),
-			"aws_eips":                                       ec2.DataSourceEIPs(),
-			"aws_instance":                                   ec2.DataSourceInstance(),
-			"aws_instances":                                  ec2.DataSourceInstances(),
-			"aws_internet_gateway":                           ec2.DataSourceInternetGateway(),
-			"aws_key_pair":                                   ec2.DataSourceKeyPair(),
-			"aws_launch_template":                            ec2.DataSourceLaunchTemplate(),
-			"aws_nat_gateway":                                ec2.DataSourceNATGateway(),
-			"aws_nat_gateways":                               ec2.DataSourceNATGateways(),
-			"aws_network_acls":                               ec2.DataSourceNetworkACLs(),
-			"aws_network_interface":                          ec2.DataSourceNetworkInterface(),
-			"aws_network_interfaces":                         ec2.DataSourceNetworkInterfaces(),
-			"aws_prefix_list":                                ec2.DataSourcePrefixList(),
-			"aws_route_table":                                ec2.DataSourceRouteTable(),
-			"aws_route_tables":                               ec2.DataSourceRouteTables(),
-			"aws_route":                                      ec2.DataSourceRoute(),
-			"aws_security_group":                             ec2.DataSourceSecurityGroup(),
-			"aws_security_groups":                            ec2.DataSourceSecurityGroups(),
-			"aws_subnet_ids":                                 ec2.DataSourceSubnetIDs(),
-			"aws_subnet":                                     ec2.DataSourceSubnet(),
-			"aws_subnets":                                    ec2.DataSourceSubnets(),
-			"aws_vpc_dhcp_options":                           ec2.DataSourceVPCDHCPOptions(),
-			"aws_vpc_endpoint_service":                       ec2.DataSourceVPCEndpointService(),
-			"aws_vpc_endpoint":                               ec2.DataSourceVPCEndpoint(),
-			"aws_vpc_ipam_pool":                              ec2.DataSourceIPAMPool(),
-			"aws_vpc_ipam_pools":                             ec2.DataSourceIPAMPools(),
-			"aws_vpc_ipam_pool_cidrs":                        ec2.DataSourceIPAMPoolCIDRs(),
-			"aws_vpc_ipam_preview_next_cidr":                 ec2.DataSourceIPAMPreviewNextCIDR(),
-			"aws_vpc_peering_connection":                     ec2.DataSourceVPCPeeringConnection(),
-			"aws_vpc_peering_connections":                    ec2.DataSourceVPCPeeringConnections(),
-			"aws_vpc":                                        ec2.DataSourceVPC(),
-			"aws_vpcs":                                       ec2.DataSourceVPCs(),
-			"aws_vpn_gateway":                                ec2.DataSourceVPNGateway(),
+			"aws_ami":                                         ec2.DataSourceAMI(),
+			"aws_ami_ids":                                     ec2.DataSourceAMIIDs(),
+			"aws_availability_zone":                           ec2.DataSourceAvailabilityZone(),
+			"aws_availability_zones":                          ec2.DataSourceAvailabilityZones(),
+			"aws_customer_gateway":                            ec2.DataSourceCustomerGateway(),
+			"aws_ebs_default_kms_key":                         ec2.DataSourceEBSDefaultKMSKey(),
+			"aws_ebs_encryption_by_default":                   ec2.DataSourceEBSEncryptionByDefault(),
+			"aws_ebs_snapshot":                                ec2.DataSourceEBSSnapshot(),
+			"aws_ebs_snapshot_ids":                            ec2.DataSourceEBSSnapshotIDs(),
+			"aws_ebs_volume":                                  ec2.DataSourceEBSVolume(),
+			"aws_ebs_volumes":                                 ec2.DataSourceEBSVolumes(),
+			"aws_ec2_client_vpn_endpoint":                     ec2.DataSourceClientVPNEndpoint(),
+			"aws_ec2_coip_pool":                               ec2.DataSourceCoIPPool(),
+			"aws_ec2_coip_pools":                              ec2.DataSourceCoIPPools(),
+			"aws_ec2_host":                                    ec2.DataSourceHost(),
+			"aws_ec2_instance_type_offering":                  ec2.DataSourceInstanceTypeOffering(),
+			"aws_ec2_instance_type_offerings":                 ec2.DataSourceInstanceTypeOfferings(),
+			"aws_ec2_instance_type":                           ec2.DataSourceInstanceType(),
+			"aws_ec2_instance_types":                          ec2.DataSourceInstanceTypes(),
+			"aws_ec2_local_gateway_route_table":               ec2.DataSourceLocalGatewayRouteTable(),
+			"aws_ec2_local_gateway_route_tables":              ec2.DataSourceLocalGatewayRouteTables(),
+			"aws_ec2_local_gateway_virtual_interface":         ec2.DataSourceLocalGatewayVirtualInterface(),
+			"aws_ec2_local_gateway_virtual_interface_group":   ec2.DataSourceLocalGatewayVirtualInterfaceGroup(),
+			"aws_ec2_local_gateway_virtual_interface_groups":  ec2.DataSourceLocalGatewayVirtualInterfaceGroups(),
+			"aws_ec2_local_gateway":                           ec2.DataSourceLocalGateway(),
+			"aws_ec2_local_gateways":                          ec2.DataSourceLocalGateways(),
+			"aws_ec2_managed_prefix_list":                     ec2.DataSourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_lists":                    ec2.DataSourceManagedPrefixLists(),
+			"aws_ec2_network_insights_analysis":               ec2.DataSourceNetworkInsightsAnalysis(),
+			"aws_ec2_network_insights_path":                   ec2.DataSourceNetworkInsightsPath(),
+			"aws_ec2_serial_console_access":                   ec2.DataSourceSerialConsoleAccess(),
+			"aws_ec2_spot_price":                              ec2.DataSourceSpotPrice(),
+			"aws_ec2_transit_gateway":                         ec2.DataSourceTransitGateway(),
+			"aws_ec2_transit_gateway_attachment":              ec2.DataSourceTransitGatewayAttachment(),
+			"aws_ec2_transit_gateway_connect":                 ec2.DataSourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":            ec2.DataSourceTransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_dx_gateway_attachment":   ec2.DataSourceTransitGatewayDxGatewayAttachment(),
+			"aws_ec2_transit_gateway_multicast_domain":        ec2.DataSourceTransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_peering_attachment":      ec2.DataSourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_peering_attachments":     ec2.DataSourceTransitGatewayPeeringAttachments(),
+			"aws_ec2_transit_gateway_route_table":             ec2.DataSourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_association": ec2.DataSourceTransitGatewayRouteTableAssociation(),
+			"aws_ec2_transit_gateway_route_tables":            ec2.DataSourceTransitGatewayRouteTables(),
+			"aws_ec2_transit_gateway_vpc_attachment":          ec2.DataSourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachments":         ec2.DataSourceTransitGatewayVPCAttachments(),
+			"aws_ec2_transit_gateway_vpn_attachment":          ec2.DataSourceTransitGatewayVPNAttachment(),
+			"aws_eip":                                         ec2.DataSourceEIP(),
+			"aws_eips":                                        ec2.DataSourceEIPs(),
+			"aws_instance":                                    ec2.DataSourceInstance(),
+			"aws_instances":                                   ec2.DataSourceInstances(),
+			"aws_internet_gateway":                            ec2.DataSourceInternetGateway(),
+			"aws_key_pair":                                    ec2.DataSourceKeyPair(),
+			"aws_launch_template":                             ec2.DataSourceLaunchTemplate(),
+			"aws_nat_gateway":                                 ec2.DataSourceNATGateway(),
+			"aws_nat_gateways":                                ec2.DataSourceNATGateways(),
+			"aws_network_acls":                                ec2.DataSourceNetworkACLs(),
+			"aws_network_interface":                           ec2.DataSourceNetworkInterface(),
+			"aws_network_interfaces":                          ec2.DataSourceNetworkInterfaces(),
+			"aws_prefix_list":                                 ec2.DataSourcePrefixList(),
+			"aws_route_table":                                 ec2.DataSourceRouteTable(),
+			"aws_route_tables":                                ec2.DataSourceRouteTables(),
+			"aws_route":                                       ec2.DataSourceRoute(),
+			"aws_security_group":                              ec2.DataSourceSecurityGroup(),
+			"aws_security_groups":                             ec2.DataSourceSecurityGroups(),
+			"aws_subnet_ids":                                  ec2.DataSourceSubnetIDs(),
+			"aws_subnet":                                      ec2.DataSourceSubnet(),
+			"aws_subnets":                                     ec2.DataSourceSubnets(),
+			"aws_vpc_dhcp_options":                            ec2.DataSourceVPCDHCPOptions(),
+			"aws_vpc_endpoint_service":                        ec2.DataSourceVPCEndpointService(),
+			"aws_vpc_endpoint":                                ec2.DataSourceVPCEndpoint(),
+			"aws_vpc_ipam_pool":                               ec2.DataSourceIPAMPool(),
+			"aws_vpc_ipam_pools":                              ec2.DataSourceIPAMPools(),
+			"aws_vpc_ipam_pool_cidrs":                         ec2.DataSourceIPAMPoolCIDRs(),
+			"aws_vpc_ipam_preview_next_cidr":                  ec2.DataSourceIPAMPreviewNextCIDR(),
+			"aws_vpc_peering_connection":                      ec2.DataSourceVPCPeeringConnection(),
+			"aws_vpc_peering_connections":                     ec2.DataSourceVPCPeeringConnections(),
+			"aws_vpc":                                         ec2.DataSourceVPC(),
+			"aws_vpcs":                                        ec2.DataSourceVPCs(),
+			"aws_vpn_gateway":                                 ec2.DataSourceVPNGateway(),
 
 			"aws_ecr_authorization_token": ecr.DataSourceAuthorizationToken(),
 			"aws_ecr_image":               ecr.DataSourceImage(),
@@ -639,9 +641,10 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_elasticache_subnet_group":      elasticache.DataSourceSubnetGroup(),
 			"aws_elasticache_user":              elasticache.DataSourceUser(),
 
-			"aws_elastic_beanstalk_application":    elasticbeanstalk.DataSourceApplication(),
-			"aws_elastic_beanstalk_hosted_zone":    elasticbeanstalk.DataSourceHostedZone(),
-			"aws_elastic_beanstalk_solution_stack": elasticbeanstalk.DataSourceSolutionStack(),
+			"aws_elastic_beanstalk_application":            elasticbeanstalk.DataSourceApplication(),
+			"aws_elastic_beanstalk_configuration_template": elasticbeanstalk.DataSourceConfigurationTemplate(),
+			"aws_elastic_beanstalk_hosted_zone":            elasticbeanstalk.DataSourceHostedZone(),
+			"aws_elastic_beanstalk_solution_stack":         elasticbeanstalk.DataSourceSolutionStack(),
 
 			"aws_elasticsearch_domain": elasticsearch.DataSourceDomain(),
 
@@ -1913,6 +1916,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			"aws_rds_cluster_instance":                      rds.ResourceClusterInstance(),
 			"aws_rds_cluster_parameter_group":               rds.ResourceClusterParameterGroup(),
 			"aws_rds_cluster_role_association":              rds.ResourceClusterRoleAssociation(),
+			"aws_rds_cluster_snapshot_copy":                 rds.ResourceClusterSnapshotCopy(),
 			"aws_rds_global_cluster":                        rds.ResourceGlobalCluster(),
 			"aws_rds_reserved_instance":                     rds.ResourceReservedInstance(),
 
@@ -103,6 +103,7 @@ const (
 	errCodePrefixListVersionMismatch                      = "PrefixListVersionMismatch"
 	errCodeResourceNotReady                               = "ResourceNotReady"
 	errCodeSnapshotCreationPerVolumeRateExceeded          = "SnapshotCreationPerVolumeRateExceeded"
+	errCodeUnauthorizedOperation                          = "UnauthorizedOperation"
 	errCodeUnsupportedOperation                           = "UnsupportedOperation"
 	errCodeVolumeInUse                                    = "VolumeInUse"
 )
@@ -2,6 +2,35 @@ package ec2
 
 // Exports for use in tests only.
 var (
-	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
-	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
+	AcceptTransitGatewayPeeringAttachment                 = acceptTransitGatewayPeeringAttachment
+	AcceptTransitGatewayPeeringAttachmentAndWait          = acceptTransitGatewayPeeringAttachmentAndWait
+	CreateTransitGatewayPeeringAttachmentAccepterTags     = createTransitGatewayPeeringAttachmentAccepterTags
+	DefaultTagsConflictingKeys                            = defaultTagsConflictingKeys
+	FilterTransitGatewayRouteTablesByAssociation          = filterTransitGatewayRouteTablesByAssociation
+	FindPeerTransitGatewayDefaultRouteTableID             = findPeerTransitGatewayDefaultRouteTableID
+	FindTransitGatewayPeeringAttachmentAccepterByID       = findTransitGatewayPeeringAttachmentAccepterByID
+	FindTransitGatewayPeeringAttachmentRequesterOptions   = findTransitGatewayPeeringAttachmentRequesterOptions
+	FindTransitGatewayRouteTableAssociationByAttachmentID = findTransitGatewayRouteTableAssociationByAttachmentID
+	FlattenTransitGatewayPeeringAttachmentAssociation     = flattenTransitGatewayPeeringAttachmentAssociation
+	FlattenTransitGatewayPeeringAttachmentOptions         = flattenTransitGatewayPeeringAttachmentOptions
+	FlattenTransitGatewayPeeringAttachmentSummaries       = flattenTransitGatewayPeeringAttachmentSummaries
+	FlattenTransitGatewayRouteTableRoutes                 = flattenTransitGatewayRouteTableRoutes
+	ResourceSecurityGroupEgressRule                       = newResourceSecurityGroupEgressRule
+	ResourceSecurityGroupIngressRule                      = newResourceSecurityGroupIngressRule
+	RouteTableAddRoute                                    = routeTableAddRoute
+	RouteTableDeleteRoute                                 = routeTableDeleteRoute
+	RouteTableUpdateRoute                                 = routeTableUpdateRoute
+	TransitGatewayDefaultRouteTable                       = transitGatewayDefaultRouteTable
+	TransitGatewayRouteTableARN                           = transitGatewayRouteTableARN
+	TransitGatewayRouteTableAssociationCount              = transitGatewayRouteTableAssociationCount
+	TransitGatewayRouteTableDescriptionFromTags           = transitGatewayRouteTableDescriptionFromTags
+	TransitGatewayRouteTableHasBlackholeRoutes            = transitGatewayRouteTableHasBlackholeRoutes
+	TransitGatewayRouteTablePropagationCount              = transitGatewayRouteTablePropagationCount
+	TransitGatewayRouteTableReadDetails                   = transitGatewayRouteTableReadDetails
+	TransitGatewayRouteTableRemoveAttachments             = transitGatewayRouteTableRemoveAttachments
+	TransitGatewayRouteTableRouteHash                     = transitGatewayRouteTableRouteHash
+	TransitGatewayRouteTableRoutesDiff                    = transitGatewayRouteTableRoutesDiff
+	TransitGatewayRouteTableStateJSON                     = transitGatewayRouteTableStateJSON
+	TransitGatewayRouteTableUnexpectedUpdateKeys          = transitGatewayRouteTableUnexpectedUpdateKeys
+	TransitGatewayRouteTableValidateRouteTargets          = transitGatewayRouteTableValidateRouteTargets
 )
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
 )
 
 func FindAvailabilityZones(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeAvailabilityZonesInput) ([]*ec2.AvailabilityZone, error) {
@@ -4496,7 +4497,9 @@ func FindTransitGatewayPeeringAttachments(ctx context.Context, conn *ec2.EC2, in
 	return output, nil
 }
 
-func FindTransitGatewayPeeringAttachmentByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayPeeringAttachment, error) {
+// FindTransitGatewayPeeringAttachmentByID returns the specified transit gateway peering attachment.
+// Deleted, failed and rejected attachments are not found unless their state is one of includeStates.
+func FindTransitGatewayPeeringAttachmentByID(ctx context.Context, conn *ec2.EC2, id string, includeStates ...string) (*ec2.TransitGatewayPeeringAttachment, error) {
 	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{
 		TransitGatewayAttachmentIds: aws.StringSlice([]string{id}),
 	}
@@ -4512,9 +4515,11 @@ func FindTransitGatewayPeeringAttachmentByID(ctx context.Context, conn *ec2.EC2,
 	case ec2.TransitGatewayAttachmentStateDeleted,
 		ec2.TransitGatewayAttachmentStateFailed,
 		ec2.TransitGatewayAttachmentStateRejected:
-		return nil, &resource.NotFoundError{
-			Message:     state,
-			LastRequest: input,
+		if !slices.Contains(includeStates, state) {
+			return nil, &resource.NotFoundError{
+				Message:     state,
+				LastRequest: input,
+			}
 		}
 	}
 
@@ -760,6 +760,31 @@ func StatusTransitGatewayRouteTableState(ctx context.Context, conn *ec2.EC2, id
 	}
 }
 
+// StatusTransitGatewayRouteTableStateIncludingDeleted is like StatusTransitGatewayRouteTableState,
+// but reports a deleted route table's state rather than treating it as not found.
+func StatusTransitGatewayRouteTableStateIncludingDeleted(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTransitGatewayRouteTable(ctx, conn, &ec2.DescribeTransitGatewayRouteTablesInput{
+			TransitGatewayRouteTableIds: aws.StringSlice([]string{id}),
+		})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Eventual consistency check.
+		if aws.StringValue(output.TransitGatewayRouteTableId) != id {
+			return nil, "", nil
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
 func StatusTransitGatewayPolicyTableState(ctx context.Context, conn *ec2.EC2, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindTransitGatewayPolicyTableByID(ctx, conn, id)
@@ -43,17 +43,26 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 			"FilterDifferentAccount": testAccTransitGatewayPeeringAttachmentDataSource_Filter_differentAccount,
 			"IDSameAccount":          testAccTransitGatewayPeeringAttachmentDataSource_ID_sameAccount,
 			"IDDifferentAccount":     testAccTransitGatewayPeeringAttachmentDataSource_ID_differentAccount,
+			"State":                  testAccTransitGatewayPeeringAttachmentDataSource_State,
 			"Tags":                   testAccTransitGatewayPeeringAttachmentDataSource_Tags,
 		},
+		"PeeringAttachments": {
+			"Orphaned": testAccTransitGatewayPeeringAttachmentsDataSource_orphaned,
+		},
 		"RouteTable": {
-			"Filter": testAccTransitGatewayRouteTableDataSource_Filter,
-			"ID":     testAccTransitGatewayRouteTableDataSource_ID,
+			"DefaultRouteTable": testAccTransitGatewayRouteTableDataSource_defaultRouteTable,
+			"Filter":            testAccTransitGatewayRouteTableDataSource_Filter,
+			"ID":                testAccTransitGatewayRouteTableDataSource_ID,
+		},
+		"RouteTableAssociation": {
+			"basic": testAccTransitGatewayRouteTableAssociationDataSource_basic,
 		},
 		"RouteTables": {
-			"basic":  testAccTransitGatewayRouteTablesDataSource_basic,
-			"Filter": testAccTransitGatewayRouteTablesDataSource_filter,
-			"Tags":   testAccTransitGatewayRouteTablesDataSource_tags,
-			"Empty":  testAccTransitGatewayRouteTablesDataSource_empty,
+			"basic":      testAccTransitGatewayRouteTablesDataSource_basic,
+			"Filter":     testAccTransitGatewayRouteTablesDataSource_filter,
+			"Tags":       testAccTransitGatewayRouteTablesDataSource_tags,
+			"Empty":      testAccTransitGatewayRouteTablesDataSource_empty,
+			"Attachment": testAccTransitGatewayRouteTablesDataSource_attachment,
 		},
 		"VpcAttachment": {
 			"Filter": testAccTransitGatewayVPCAttachmentDataSource_Filter,
@@ -161,7 +161,7 @@ func resourceTransitGatewayPeeringAttachmentDelete(ctx context.Context, d *schem
 		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Peering Attachment (%s): %s", d.Id(), err)
 	}
 
-	if _, err := WaitTransitGatewayPeeringAttachmentDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := WaitTransitGatewayPeeringAttachmentDeleted(ctx, conn, d.Id(), TransitGatewayPeeringAttachmentDeletedTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Peering Attachment (%s) delete: %s", d.Id(), err)
 	}
 
@@ -2,12 +2,16 @@ package ec2
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -24,12 +28,53 @@ func ResourceTransitGatewayPeeringAttachmentAccepter() *schema.Resource {
 		DeleteWithoutTimeout: resourceTransitGatewayPeeringAttachmentAccepterDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceTransitGatewayPeeringAttachmentAccepterImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
 
 		Schema: map[string]*schema.Schema{
+			"association": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dynamic_routing": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"peer_account_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -38,16 +83,37 @@ func ResourceTransitGatewayPeeringAttachmentAccepter() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"peer_transit_gateway_default_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"peer_transit_gateway_id": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"requester_options": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dynamic_routing": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"transit_gateway_attachment_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validTransitGatewayAttachmentID,
 			},
 			"transit_gateway_id": {
 				Type:     schema.TypeString,
@@ -64,25 +130,22 @@ func resourceTransitGatewayPeeringAttachmentAccepterCreate(ctx context.Context,
 	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	transitGatewayAttachmentID := d.Get("transit_gateway_attachment_id").(string)
-	input := &ec2.AcceptTransitGatewayPeeringAttachmentInput{
-		TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
-	}
-
-	log.Printf("[DEBUG] Accepting EC2 Transit Gateway Peering Attachment: %s", input)
-	output, err := conn.AcceptTransitGatewayPeeringAttachmentWithContext(ctx, input)
+	requesterOptions, err := findTransitGatewayPeeringAttachmentRequesterOptions(ctx, conn, transitGatewayAttachmentID)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "accepting EC2 Transit Gateway Peering Attachment (%s): %s", transitGatewayAttachmentID, err)
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Peering Attachment (%s): %s", transitGatewayAttachmentID, err)
 	}
 
-	d.SetId(aws.StringValue(output.TransitGatewayPeeringAttachment.TransitGatewayAttachmentId))
+	if err := d.Set("requester_options", flattenTransitGatewayPeeringAttachmentOptions(requesterOptions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting requester_options: %s", err)
+	}
 
-	if _, err := WaitTransitGatewayPeeringAttachmentAccepted(ctx, conn, d.Id()); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Peering Attachment (%s) update: %s", d.Id(), err)
+	if err := acceptTransitGatewayPeeringAttachmentAndWait(ctx, conn, d, transitGatewayAttachmentID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
 
 	if len(tags) > 0 {
-		if err := CreateTags(ctx, conn, d.Id(), tags); err != nil {
+		if err := createTransitGatewayPeeringAttachmentAccepterTags(ctx, conn, d.Id(), tags, transitGatewayPeeringAttachmentAccepterTagsTimeout); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Peering Attachment (%s) tags: %s", d.Id(), err)
 		}
 	}
@@ -96,7 +159,7 @@ func resourceTransitGatewayPeeringAttachmentAccepterRead(ctx context.Context, d
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	transitGatewayPeeringAttachment, err := FindTransitGatewayPeeringAttachmentByID(ctx, conn, d.Id())
+	transitGatewayPeeringAttachment, err := findTransitGatewayPeeringAttachmentAccepterByID(ctx, conn, d.Id())
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] EC2 Transit Gateway Peering Attachment (%s) not found, removing from state", d.Id())
@@ -115,9 +178,42 @@ func resourceTransitGatewayPeeringAttachmentAccepterRead(ctx context.Context, d
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
 	}
 
+	transitGatewayAttachment, err := FindTransitGatewayAttachmentByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Attachment (%s): %s", d.Id(), err)
+	}
+
+	// The peer transit gateway may be in another Region.
+	peerConn := conn
+	if peerRegion := aws.StringValue(transitGatewayPeeringAttachment.RequesterTgwInfo.Region); peerRegion != "" && peerRegion != meta.(*conns.AWSClient).Region {
+		peerConn = ec2.New(meta.(*conns.AWSClient).Session, aws.NewConfig().WithRegion(peerRegion))
+	}
+
+	peerTransitGatewayID := aws.StringValue(transitGatewayPeeringAttachment.RequesterTgwInfo.TransitGatewayId)
+	peerTransitGatewayDefaultRouteTableID, err := findPeerTransitGatewayDefaultRouteTableID(ctx, peerConn, peerTransitGatewayID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", peerTransitGatewayID, err)
+	}
+
+	if err := d.Set("association", flattenTransitGatewayPeeringAttachmentAssociation(transitGatewayAttachment)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting association: %s", err)
+	}
+	if err := d.Set("options", flattenTransitGatewayPeeringAttachmentOptions(transitGatewayPeeringAttachment.Options)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting options: %s", err)
+	}
+	// Attachment options can't be modified, so on import the requester's options are the current ones.
+	if _, ok := d.GetOk("requester_options"); !ok {
+		if err := d.Set("requester_options", flattenTransitGatewayPeeringAttachmentOptions(transitGatewayPeeringAttachment.Options)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting requester_options: %s", err)
+		}
+	}
 	d.Set("peer_account_id", transitGatewayPeeringAttachment.RequesterTgwInfo.OwnerId)
 	d.Set("peer_region", transitGatewayPeeringAttachment.RequesterTgwInfo.Region)
+	d.Set("peer_transit_gateway_default_route_table_id", peerTransitGatewayDefaultRouteTableID)
 	d.Set("peer_transit_gateway_id", transitGatewayPeeringAttachment.RequesterTgwInfo.TransitGatewayId)
+	d.Set("state", transitGatewayPeeringAttachment.State)
 	d.Set("transit_gateway_attachment_id", transitGatewayPeeringAttachment.TransitGatewayAttachmentId)
 	d.Set("transit_gateway_id", transitGatewayPeeringAttachment.AccepterTgwInfo.TransitGatewayId)
 
@@ -167,9 +263,194 @@ func resourceTransitGatewayPeeringAttachmentAccepterDelete(ctx context.Context,
 		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Peering Attachment (%s): %s", d.Id(), err)
 	}
 
-	if _, err := WaitTransitGatewayPeeringAttachmentDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := WaitTransitGatewayPeeringAttachmentDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Peering Attachment (%s) delete: %s", d.Id(), err)
 	}
 
 	return diags
 }
+
+// resourceTransitGatewayPeeringAttachmentAccepterImport rejects IDs that are not transit gateway attachment IDs,
+// e.g. transit gateway IDs, before they are read. Tags are then read as for any other refresh.
+func resourceTransitGatewayPeeringAttachmentAccepterImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, errs := validTransitGatewayAttachmentID(d.Id(), "id"); len(errs) > 0 {
+		return nil, fmt.Errorf("importing EC2 Transit Gateway Peering Attachment Accepter (%s): %w", d.Id(), errs[0])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// An accepted attachment is occasionally not yet visible to CreateTags.
+const transitGatewayPeeringAttachmentAccepterTagsTimeout = 45 * time.Second
+
+// createTransitGatewayPeeringAttachmentAccepterTags tags a newly accepted transit gateway peering attachment.
+// The call is retried for a short time while the attachment is not yet visible to CreateTags.
+func createTransitGatewayPeeringAttachmentAccepterTags(ctx context.Context, conn *ec2.EC2, id string, tags tftags.KeyValueTags, timeout time.Duration) error {
+	input := &ec2.CreateTagsInput{
+		Resources: aws.StringSlice([]string{id}),
+		Tags:      Tags(tags.IgnoreAWS()),
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+		return conn.CreateTagsWithContext(ctx, input)
+	}, errCodeInvalidTransitGatewayAttachmentIDNotFound)
+
+	return err
+}
+
+// findTransitGatewayPeeringAttachmentRequesterOptions returns the options proposed by the requester of a transit gateway peering attachment.
+// It is called before the attachment is accepted.
+func findTransitGatewayPeeringAttachmentRequesterOptions(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayPeeringAttachmentOptions, error) {
+	transitGatewayPeeringAttachment, err := FindTransitGatewayPeeringAttachmentByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return transitGatewayPeeringAttachment.Options, nil
+}
+
+// acceptTransitGatewayPeeringAttachmentAndWait accepts the specified peering attachment and waits for it to become available.
+// The resource ID is only set once the attachment has been accepted, so a failure to accept leaves nothing in state.
+// A failure or timeout while waiting leaves the accepted attachment in state, where it is marked as tainted.
+func acceptTransitGatewayPeeringAttachmentAndWait(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData, transitGatewayAttachmentID string, timeout time.Duration) error {
+	input := &ec2.AcceptTransitGatewayPeeringAttachmentInput{
+		TransitGatewayAttachmentId: aws.String(transitGatewayAttachmentID),
+	}
+
+	log.Printf("[DEBUG] Accepting EC2 Transit Gateway Peering Attachment: %s", input)
+	id, err := acceptTransitGatewayPeeringAttachment(ctx, conn, input)
+
+	if err != nil {
+		return fmt.Errorf("accepting EC2 Transit Gateway Peering Attachment (%s): %w", transitGatewayAttachmentID, err)
+	}
+
+	d.SetId(id)
+
+	_, err = WaitTransitGatewayPeeringAttachmentAccepted(ctx, conn, id, timeout)
+
+	if tfresource.TimedOut(err) || errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("timed out waiting for accepted EC2 Transit Gateway Peering Attachment (%s) to become available; the resource has been marked as tainted: %w", id, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("waiting for accepted EC2 Transit Gateway Peering Attachment (%s) to become available: %w", id, err)
+	}
+
+	return nil
+}
+
+// acceptTransitGatewayPeeringAttachment accepts a transit gateway peering attachment and returns its ID.
+// The accept output occasionally omits the attachment ID, in which case it is read back from the attachment.
+func acceptTransitGatewayPeeringAttachment(ctx context.Context, conn *ec2.EC2, input *ec2.AcceptTransitGatewayPeeringAttachmentInput) (string, error) {
+	output, err := conn.AcceptTransitGatewayPeeringAttachmentWithContext(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output != nil && output.TransitGatewayPeeringAttachment != nil {
+		if id := aws.StringValue(output.TransitGatewayPeeringAttachment.TransitGatewayAttachmentId); id != "" {
+			return id, nil
+		}
+	}
+
+	transitGatewayPeeringAttachment, err := FindTransitGatewayPeeringAttachmentByID(ctx, conn, aws.StringValue(input.TransitGatewayAttachmentId))
+
+	if err != nil {
+		return "", fmt.Errorf("reading attachment ID: %w", err)
+	}
+
+	id := aws.StringValue(transitGatewayPeeringAttachment.TransitGatewayAttachmentId)
+
+	if id == "" {
+		return "", errors.New("empty attachment ID")
+	}
+
+	return id, nil
+}
+
+// findTransitGatewayPeeringAttachmentAccepterByID returns the specified peering attachment for the accepter to read.
+// Attachments that are deleted, being deleted or rejected are not found, so that they are removed from state.
+// A failed attachment cannot be used or recovered, so an error is returned rather than treating it as removed,
+// which would cause the accepter to be silently recreated on every apply.
+func findTransitGatewayPeeringAttachmentAccepterByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayPeeringAttachment, error) {
+	transitGatewayPeeringAttachment, err := FindTransitGatewayPeeringAttachmentByID(ctx, conn, id, ec2.TransitGatewayAttachmentStateFailed)
+
+	if err != nil {
+		return nil, err
+	}
+
+	switch state := aws.StringValue(transitGatewayPeeringAttachment.State); state {
+	case ec2.TransitGatewayAttachmentStateDeleting:
+		return nil, &resource.NotFoundError{
+			Message: state,
+		}
+	case ec2.TransitGatewayAttachmentStateFailed:
+		return nil, fmt.Errorf("attachment is in the %s state; remove it from the Terraform state or replace it", state)
+	}
+
+	return transitGatewayPeeringAttachment, nil
+}
+
+// findPeerTransitGatewayDefaultRouteTableID returns the default association route table ID of the specified peer transit gateway.
+// A peer transit gateway owned by another account is usually not visible to the caller, in which case an empty ID is returned.
+func findPeerTransitGatewayDefaultRouteTableID(ctx context.Context, conn *ec2.EC2, id string) (string, error) {
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeUnauthorizedOperation) {
+		log.Printf("[DEBUG] EC2 Transit Gateway (%s) not accessible, peer default route table unknown: %s", id, err)
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId), nil
+}
+
+func flattenTransitGatewayPeeringAttachmentOptions(apiObject *ec2.TransitGatewayPeeringAttachmentOptions) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DynamicRouting; v != nil {
+		tfMap["dynamic_routing"] = aws.StringValue(v)
+	}
+
+	return []interface{}{tfMap}
+}
+
+// flattenTransitGatewayPeeringAttachmentAssociation flattens the attachment's route table association.
+// The resource is always reported, while the association state and route table are only set once the
+// attachment has been associated with a transit gateway route table.
+func flattenTransitGatewayPeeringAttachmentAssociation(apiObject *ec2.TransitGatewayAttachment) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ResourceId; v != nil {
+		tfMap["resource_id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ResourceType; v != nil {
+		tfMap["resource_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Association; v != nil {
+		if v := v.State; v != nil {
+			tfMap["state"] = aws.StringValue(v)
+		}
+
+		if v := v.TransitGatewayRouteTableId; v != nil {
+			tfMap["transit_gateway_route_table_id"] = aws.StringValue(v)
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -1,17 +1,647 @@
 package ec2_test
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"reflect"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestAcceptTransitGatewayPeeringAttachment_nilID(t *testing.T) {
+	t.Parallel()
+
+	const id = "tgw-attach-12345678"
+
+	testCases := map[string]struct {
+		describedID string
+		expectError bool
+	}{
+		"populated describe": {
+			describedID: id,
+		},
+		"empty describe": {
+			expectError: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch output := r.Data.(type) {
+				case *ec2.AcceptTransitGatewayPeeringAttachmentOutput:
+					output.TransitGatewayPeeringAttachment = &ec2.TransitGatewayPeeringAttachment{}
+				case *ec2.DescribeTransitGatewayPeeringAttachmentsOutput:
+					if testCase.describedID != "" {
+						output.TransitGatewayPeeringAttachments = []*ec2.TransitGatewayPeeringAttachment{{
+							AccepterTgwInfo:            &ec2.PeeringTgwInfo{},
+							RequesterTgwInfo:           &ec2.PeeringTgwInfo{},
+							State:                      aws.String(ec2.TransitGatewayAttachmentStatePending),
+							TransitGatewayAttachmentId: aws.String(testCase.describedID),
+						}}
+					}
+				}
+			})
+
+			got, err := tfec2.AcceptTransitGatewayPeeringAttachment(context.Background(), conn, &ec2.AcceptTransitGatewayPeeringAttachmentInput{
+				TransitGatewayAttachmentId: aws.String(id),
+			})
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got ID %q", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != id {
+				t.Errorf("got ID %q, expected %q", got, id)
+			}
+		})
+	}
+}
+
+func TestCreateTransitGatewayPeeringAttachmentAccepterTags(t *testing.T) {
+	t.Parallel()
+
+	const id = "tgw-attach-12345678"
+
+	testCases := map[string]struct {
+		errCode       string
+		failures      int
+		expectError   bool
+		expectedCalls int
+	}{
+		"success": {
+			expectedCalls: 1,
+		},
+		"transient not found": {
+			errCode:       "InvalidTransitGatewayAttachmentID.NotFound",
+			failures:      1,
+			expectedCalls: 2,
+		},
+		"other error": {
+			errCode:       "UnauthorizedOperation",
+			failures:      1,
+			expectError:   true,
+			expectedCalls: 1,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if _, ok := r.Data.(*ec2.CreateTagsOutput); !ok {
+					return
+				}
+
+				calls++
+				if calls <= testCase.failures {
+					r.Error = awserr.New(testCase.errCode, "test error", nil)
+				}
+			})
+
+			err := tfec2.CreateTransitGatewayPeeringAttachmentAccepterTags(context.Background(), conn, id, tftags.New(map[string]interface{}{"Name": "test"}), 30*time.Second)
+
+			if testCase.expectError && err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !testCase.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if calls != testCase.expectedCalls {
+				t.Errorf("got %d CreateTags calls, expected %d", calls, testCase.expectedCalls)
+			}
+		})
+	}
+}
+
+func TestAcceptTransitGatewayPeeringAttachmentAndWait(t *testing.T) {
+	t.Parallel()
+
+	const id = "tgw-attach-12345678"
+
+	testCases := map[string]struct {
+		acceptErr     error
+		contextExpiry time.Duration
+		timeout       time.Duration
+		expectedID    string
+		expectedError *regexp.Regexp
+	}{
+		"accept error": {
+			acceptErr:     awserr.New("IncorrectState", "test error", nil),
+			timeout:       time.Minute,
+			expectedError: regexp.MustCompile(`accepting EC2 Transit Gateway Peering Attachment`),
+		},
+		"waiter timeout": {
+			timeout:       time.Millisecond,
+			expectedID:    id,
+			expectedError: regexp.MustCompile(`timed out .* marked as tainted`),
+		},
+		"context deadline exceeded": {
+			contextExpiry: 100 * time.Millisecond,
+			timeout:       time.Minute,
+			expectedID:    id,
+			expectedError: regexp.MustCompile(`timed out .* marked as tainted`),
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if testCase.contextExpiry > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, testCase.contextExpiry)
+				defer cancel()
+			}
+
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch output := r.Data.(type) {
+				case *ec2.AcceptTransitGatewayPeeringAttachmentOutput:
+					if testCase.acceptErr != nil {
+						r.Error = testCase.acceptErr
+						return
+					}
+					output.TransitGatewayPeeringAttachment = &ec2.TransitGatewayPeeringAttachment{
+						State:                      aws.String(ec2.TransitGatewayAttachmentStatePending),
+						TransitGatewayAttachmentId: aws.String(id),
+					}
+				case *ec2.DescribeTransitGatewayPeeringAttachmentsOutput:
+					output.TransitGatewayPeeringAttachments = []*ec2.TransitGatewayPeeringAttachment{{
+						State:                      aws.String(ec2.TransitGatewayAttachmentStatePending),
+						TransitGatewayAttachmentId: aws.String(id),
+					}}
+				}
+			})
+
+			d := tfec2.ResourceTransitGatewayPeeringAttachmentAccepter().TestResourceData()
+
+			err := tfec2.AcceptTransitGatewayPeeringAttachmentAndWait(ctx, conn, d, id, testCase.timeout)
+
+			if err == nil || !testCase.expectedError.MatchString(err.Error()) {
+				t.Errorf("got error %v, expected error matching %q", err, testCase.expectedError)
+			}
+
+			if got := d.Id(); got != testCase.expectedID {
+				t.Errorf("got ID %q, expected %q", got, testCase.expectedID)
+			}
+		})
+	}
+}
+
+func TestResourceTransitGatewayPeeringAttachmentAccepterImport(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		id          string
+		expectError bool
+	}{
+		"attachment ID": {
+			id: "tgw-attach-0123456789abcdef0",
+		},
+		"transit gateway ID": {
+			id:          "tgw-0123456789abcdef0",
+			expectError: true,
+		},
+		"empty": {
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := tfec2.ResourceTransitGatewayPeeringAttachmentAccepter()
+			d := r.TestResourceData()
+			d.SetId(testCase.id)
+
+			got, err := r.Importer.StateContext(context.Background(), d, nil)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 || got[0].Id() != testCase.id {
+				t.Errorf("got %v, expected a single resource with ID %q", got, testCase.id)
+			}
+		})
+	}
+}
+
+func TestFindPeerTransitGatewayDefaultRouteTableID(t *testing.T) {
+	t.Parallel()
+
+	const (
+		id                  = "tgw-12345678"
+		defaultRouteTableID = "tgw-rtb-12345678"
+	)
+
+	testCases := map[string]struct {
+		errCode     string
+		expected    string
+		expectError bool
+	}{
+		"describe permitted": {
+			expected: defaultRouteTableID,
+		},
+		"not found": {
+			errCode: "InvalidTransitGatewayID.NotFound",
+		},
+		"unauthorized": {
+			errCode: "UnauthorizedOperation",
+		},
+		"other error": {
+			errCode:     "InternalError",
+			expectError: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if testCase.errCode != "" {
+					r.Error = awserr.New(testCase.errCode, "test", nil)
+					return
+				}
+
+				r.Data.(*ec2.DescribeTransitGatewaysOutput).TransitGateways = []*ec2.TransitGateway{{
+					Options: &ec2.TransitGatewayOptions{
+						AssociationDefaultRouteTableId: aws.String(defaultRouteTableID),
+					},
+					State:            aws.String(ec2.TransitGatewayStateAvailable),
+					TransitGatewayId: aws.String(id),
+				}}
+			})
+
+			got, err := tfec2.FindPeerTransitGatewayDefaultRouteTableID(context.Background(), conn, id)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatalf("expected error, got %q", got)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFindTransitGatewayPeeringAttachmentByID_includeStates(t *testing.T) {
+	t.Parallel()
+
+	const id = "tgw-attach-12345678"
+
+	testCases := map[string]struct {
+		state            string
+		expectNotFound   bool
+		expectNotFoundID bool
+	}{
+		"available": {
+			state: ec2.TransitGatewayAttachmentStateAvailable,
+		},
+		"pending acceptance": {
+			state: ec2.TransitGatewayAttachmentStatePendingAcceptance,
+		},
+		"failed": {
+			state:            ec2.TransitGatewayAttachmentStateFailed,
+			expectNotFoundID: true,
+		},
+		"rejected": {
+			state:            ec2.TransitGatewayAttachmentStateRejected,
+			expectNotFound:   true,
+			expectNotFoundID: true,
+		},
+		"deleted": {
+			state:            ec2.TransitGatewayAttachmentStateDeleted,
+			expectNotFound:   true,
+			expectNotFoundID: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				r.Data.(*ec2.DescribeTransitGatewayPeeringAttachmentsOutput).TransitGatewayPeeringAttachments = []*ec2.TransitGatewayPeeringAttachment{{
+					AccepterTgwInfo:            &ec2.PeeringTgwInfo{},
+					RequesterTgwInfo:           &ec2.PeeringTgwInfo{},
+					State:                      aws.String(testCase.state),
+					TransitGatewayAttachmentId: aws.String(id),
+				}}
+			})
+
+			output, err := tfec2.FindTransitGatewayPeeringAttachmentByID(context.Background(), conn, id, ec2.TransitGatewayAttachmentStateFailed)
+
+			if got, expected := tfresource.NotFound(err), testCase.expectNotFound; got != expected {
+				t.Errorf("including failed: got not found %t, expected %t (err: %v)", got, expected, err)
+			}
+
+			if err == nil && aws.StringValue(output.State) != testCase.state {
+				t.Errorf("including failed: got state %q, expected %q", aws.StringValue(output.State), testCase.state)
+			}
+
+			_, err = tfec2.FindTransitGatewayPeeringAttachmentByID(context.Background(), conn, id)
+
+			if got, expected := tfresource.NotFound(err), testCase.expectNotFoundID; got != expected {
+				t.Errorf("by ID: got not found %t, expected %t (err: %v)", got, expected, err)
+			}
+		})
+	}
+}
+
+func TestFindTransitGatewayPeeringAttachmentAccepterByID(t *testing.T) {
+	t.Parallel()
+
+	const id = "tgw-attach-12345678"
+
+	testCases := map[string]struct {
+		state          string
+		expectError    bool
+		expectNotFound bool
+	}{
+		"available": {
+			state: ec2.TransitGatewayAttachmentStateAvailable,
+		},
+		"deleting": {
+			state:          ec2.TransitGatewayAttachmentStateDeleting,
+			expectError:    true,
+			expectNotFound: true,
+		},
+		"deleted": {
+			state:          ec2.TransitGatewayAttachmentStateDeleted,
+			expectError:    true,
+			expectNotFound: true,
+		},
+		"failed": {
+			state:       ec2.TransitGatewayAttachmentStateFailed,
+			expectError: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				r.Data.(*ec2.DescribeTransitGatewayPeeringAttachmentsOutput).TransitGatewayPeeringAttachments = []*ec2.TransitGatewayPeeringAttachment{{
+					AccepterTgwInfo:            &ec2.PeeringTgwInfo{},
+					RequesterTgwInfo:           &ec2.PeeringTgwInfo{},
+					State:                      aws.String(testCase.state),
+					TransitGatewayAttachmentId: aws.String(id),
+				}}
+			})
+
+			_, err := tfec2.FindTransitGatewayPeeringAttachmentAccepterByID(context.Background(), conn, id)
+
+			if got := err != nil; got != testCase.expectError {
+				t.Errorf("got error %t, expected %t (err: %v)", got, testCase.expectError, err)
+			}
+
+			if got := tfresource.NotFound(err); got != testCase.expectNotFound {
+				t.Errorf("got not found %t, expected %t (err: %v)", got, testCase.expectNotFound, err)
+			}
+		})
+	}
+}
+
+func TestFindTransitGatewayPeeringAttachmentRequesterOptions(t *testing.T) {
+	t.Parallel()
+
+	const id = "tgw-attach-12345678"
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := ec2.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		if output, ok := r.Data.(*ec2.DescribeTransitGatewayPeeringAttachmentsOutput); ok {
+			output.TransitGatewayPeeringAttachments = []*ec2.TransitGatewayPeeringAttachment{{
+				AccepterTgwInfo: &ec2.PeeringTgwInfo{},
+				Options: &ec2.TransitGatewayPeeringAttachmentOptions{
+					DynamicRouting: aws.String(ec2.DynamicRoutingValueEnable),
+				},
+				RequesterTgwInfo:           &ec2.PeeringTgwInfo{},
+				State:                      aws.String(ec2.TransitGatewayAttachmentStatePendingAcceptance),
+				TransitGatewayAttachmentId: aws.String(id),
+			}}
+		}
+	})
+
+	got, err := tfec2.FindTransitGatewayPeeringAttachmentRequesterOptions(context.Background(), conn, id)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := aws.StringValue(got.DynamicRouting); got != ec2.DynamicRoutingValueEnable {
+		t.Errorf("got dynamic routing %q, expected %q", got, ec2.DynamicRoutingValueEnable)
+	}
+}
+
+func TestFlattenTransitGatewayPeeringAttachmentAssociation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *ec2.TransitGatewayAttachment
+		expected  []interface{}
+	}{
+		"nil": {},
+		"not associated": {
+			apiObject: &ec2.TransitGatewayAttachment{
+				ResourceId:   aws.String("tgw-12345678"),
+				ResourceType: aws.String(ec2.TransitGatewayAttachmentResourceTypePeering),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"resource_id":   "tgw-12345678",
+					"resource_type": ec2.TransitGatewayAttachmentResourceTypePeering,
+				},
+			},
+		},
+		"associated": {
+			apiObject: &ec2.TransitGatewayAttachment{
+				Association: &ec2.TransitGatewayAttachmentAssociation{
+					State:                      aws.String(ec2.TransitGatewayAssociationStateAssociated),
+					TransitGatewayRouteTableId: aws.String("tgw-rtb-12345678"),
+				},
+				ResourceId:   aws.String("tgw-12345678"),
+				ResourceType: aws.String(ec2.TransitGatewayAttachmentResourceTypePeering),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"resource_id":                    "tgw-12345678",
+					"resource_type":                  ec2.TransitGatewayAttachmentResourceTypePeering,
+					"state":                          ec2.TransitGatewayAssociationStateAssociated,
+					"transit_gateway_route_table_id": "tgw-rtb-12345678",
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfec2.FlattenTransitGatewayPeeringAttachmentAssociation(testCase.apiObject)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenTransitGatewayPeeringAttachmentOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObject *ec2.TransitGatewayPeeringAttachmentOptions
+		expected  []interface{}
+	}{
+		"nil": {},
+		"dynamic routing enabled": {
+			apiObject: &ec2.TransitGatewayPeeringAttachmentOptions{
+				DynamicRouting: aws.String(ec2.DynamicRoutingValueEnable),
+			},
+			expected: []interface{}{
+				map[string]interface{}{
+					"dynamic_routing": ec2.DynamicRoutingValueEnable,
+				},
+			},
+		},
+		"empty": {
+			apiObject: &ec2.TransitGatewayPeeringAttachmentOptions{},
+			expected: []interface{}{
+				map[string]interface{}{},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfec2.FlattenTransitGatewayPeeringAttachmentOptions(testCase.apiObject)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %#v, expected %#v", got, testCase.expected)
+			}
+		})
+	}
+}
+
 func testAccTransitGatewayPeeringAttachmentAccepter_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var transitGatewayPeeringAttachment ec2.TransitGatewayPeeringAttachment
@@ -35,9 +665,17 @@ func testAccTransitGatewayPeeringAttachmentAccepter_basic(t *testing.T) {
 				Config: testAccTransitGatewayPeeringAttachmentAccepterConfig_sameAccount(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTransitGatewayPeeringAttachmentExists(ctx, resourceName, &transitGatewayPeeringAttachment),
+					resource.TestCheckResourceAttr(resourceName, "association.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "association.0.resource_type", ec2.TransitGatewayAttachmentResourceTypePeering),
+					resource.TestCheckResourceAttr(resourceName, "options.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "options.0.dynamic_routing"),
 					resource.TestCheckResourceAttrPair(resourceName, "peer_account_id", transitGatewayResourceNamePeer, "owner_id"),
 					resource.TestCheckResourceAttr(resourceName, "peer_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_transit_gateway_default_route_table_id", transitGatewayResourceNamePeer, "association_default_route_table_id"),
 					resource.TestCheckResourceAttrPair(resourceName, "peer_transit_gateway_id", transitGatewayResourceNamePeer, "id"),
+					resource.TestCheckResourceAttr(resourceName, "requester_options.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "requester_options.0.dynamic_routing", resourceName, "options.0.dynamic_routing"),
+					resource.TestCheckResourceAttr(resourceName, "state", ec2.TransitGatewayAttachmentStateAvailable),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
 					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_attachment_id", peeringAttachmentName, "id"),
@@ -92,6 +730,12 @@ func testAccTransitGatewayPeeringAttachmentAccepter_Tags(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
 				),
 			},
+			{
+				Config:            testAccTransitGatewayPeeringAttachmentAccepterConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccTransitGatewayPeeringAttachmentAccepterConfig_tags1(rName, "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
@@ -100,6 +744,46 @@ func testAccTransitGatewayPeeringAttachmentAccepter_Tags(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
 				),
 			},
+			{
+				Config:        testAccTransitGatewayPeeringAttachmentAccepterConfig_tags1(rName, "key2", "value2"),
+				ResourceName:  resourceName,
+				ImportState:   true,
+				ImportStateId: "tgw-0123456789abcdef0",
+				ExpectError:   regexp.MustCompile(`doesn't look like an EC2 Transit Gateway Attachment ID`),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayPeeringAttachmentAccepter_timeouts(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayPeeringAttachment ec2.TransitGatewayPeeringAttachment
+	resourceName := "aws_ec2_transit_gateway_peering_attachment_accepter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckTransitGatewayPeeringAttachmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayPeeringAttachmentAccepterConfig_timeouts(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayPeeringAttachmentExists(ctx, resourceName, &transitGatewayPeeringAttachment),
+				),
+			},
+			{
+				Config:                  testAccTransitGatewayPeeringAttachmentAccepterConfig_timeouts(rName),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
 		},
 	})
 }
@@ -129,6 +813,7 @@ func testAccTransitGatewayPeeringAttachmentAccepter_differentAccount(t *testing.
 					testAccCheckTransitGatewayPeeringAttachmentExists(ctx, resourceName, &transitGatewayPeeringAttachment),
 					resource.TestCheckResourceAttrPair(resourceName, "peer_account_id", transitGatewayResourceNamePeer, "owner_id"),
 					resource.TestCheckResourceAttr(resourceName, "peer_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(resourceName, "peer_transit_gateway_default_route_table_id", ""),
 					resource.TestCheckResourceAttrPair(resourceName, "peer_transit_gateway_id", transitGatewayResourceNamePeer, "id"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
 					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
@@ -202,6 +887,21 @@ resource "aws_ec2_transit_gateway_peering_attachment_accepter" "test" {
 `)
 }
 
+func testAccTransitGatewayPeeringAttachmentAccepterConfig_timeouts(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigAlternateRegionProvider(),
+		testAccTransitGatewayPeeringAttachmentAccepterConfig_base(rName),
+		`
+resource "aws_ec2_transit_gateway_peering_attachment_accepter" "test" {
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_peering_attachment.test.id
+
+  timeouts {
+    create = "20m"
+  }
+}
+`)
+}
+
 func testAccTransitGatewayPeeringAttachmentAccepterConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAlternateRegionProvider(),
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -41,6 +42,12 @@ func DataSourceTransitGatewayPeeringAttachment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ec2.TransitGatewayAttachmentState_Values(), false),
+			},
 			"tags": tftags.TagsSchemaComputed(),
 			"transit_gateway_id": {
 				Type:     schema.TypeString,
@@ -55,7 +62,11 @@ func dataSourceTransitGatewayPeeringAttachmentRead(ctx context.Context, d *schem
 	conn := meta.(*conns.AWSClient).EC2Conn()
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
-	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{}
+	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"state": d.Get("state").(string),
+		}),
+	}
 
 	input.Filters = append(input.Filters, BuildCustomFilterList(
 		d.Get("filter").(*schema.Set),
@@ -84,17 +95,12 @@ func dataSourceTransitGatewayPeeringAttachmentRead(ctx context.Context, d *schem
 
 	d.SetId(aws.StringValue(transitGatewayPeeringAttachment.TransitGatewayAttachmentId))
 
-	local := transitGatewayPeeringAttachment.RequesterTgwInfo
-	peer := transitGatewayPeeringAttachment.AccepterTgwInfo
-
-	if aws.StringValue(transitGatewayPeeringAttachment.AccepterTgwInfo.OwnerId) == meta.(*conns.AWSClient).AccountID && aws.StringValue(transitGatewayPeeringAttachment.AccepterTgwInfo.Region) == meta.(*conns.AWSClient).Region {
-		local = transitGatewayPeeringAttachment.AccepterTgwInfo
-		peer = transitGatewayPeeringAttachment.RequesterTgwInfo
-	}
+	local, peer := transitGatewayPeeringAttachmentLocalAndPeer(transitGatewayPeeringAttachment, meta.(*conns.AWSClient).AccountID, meta.(*conns.AWSClient).Region)
 
 	d.Set("peer_account_id", peer.OwnerId)
 	d.Set("peer_region", peer.Region)
 	d.Set("peer_transit_gateway_id", peer.TransitGatewayId)
+	d.Set("state", transitGatewayPeeringAttachment.State)
 	d.Set("transit_gateway_id", local.TransitGatewayId)
 
 	if err := d.Set("tags", KeyValueTags(transitGatewayPeeringAttachment.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
@@ -103,3 +109,13 @@ func dataSourceTransitGatewayPeeringAttachmentRead(ctx context.Context, d *schem
 
 	return diags
 }
+
+// transitGatewayPeeringAttachmentLocalAndPeer returns the local and peer transit gateway information of the specified
+// attachment. The accepter side is local if it is in the specified account and Region, otherwise the requester side is.
+func transitGatewayPeeringAttachmentLocalAndPeer(apiObject *ec2.TransitGatewayPeeringAttachment, accountID, region string) (*ec2.PeeringTgwInfo, *ec2.PeeringTgwInfo) {
+	if aws.StringValue(apiObject.AccepterTgwInfo.OwnerId) == accountID && aws.StringValue(apiObject.AccepterTgwInfo.Region) == region {
+		return apiObject.AccepterTgwInfo, apiObject.RequesterTgwInfo
+	}
+
+	return apiObject.RequesterTgwInfo, apiObject.AccepterTgwInfo
+}
@@ -131,6 +131,35 @@ func testAccTransitGatewayPeeringAttachmentDataSource_ID_differentAccount(t *tes
 	})
 }
 
+func testAccTransitGatewayPeeringAttachmentDataSource_State(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_peering_attachment.test"
+	resourceName := "aws_ec2_transit_gateway_peering_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTransitGateway(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckTransitGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayPeeringAttachmentDataSourceConfig_stateSameAccount(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "peer_transit_gateway_id", dataSourceName, "peer_transit_gateway_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "state", ec2.TransitGatewayAttachmentStatePendingAcceptance),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", dataSourceName, "transit_gateway_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGatewayPeeringAttachmentDataSource_Tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -180,6 +209,19 @@ data "aws_ec2_transit_gateway_peering_attachment" "test" {
 `)
 }
 
+func testAccTransitGatewayPeeringAttachmentDataSourceConfig_stateSameAccount(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayPeeringAttachmentConfig_sameAccount(rName), `
+data "aws_ec2_transit_gateway_peering_attachment" "test" {
+  state = "pendingAcceptance"
+
+  filter {
+    name   = "transit-gateway-attachment-id"
+    values = [aws_ec2_transit_gateway_peering_attachment.test.id]
+  }
+}
+`)
+}
+
 func testAccTransitGatewayPeeringAttachmentDataSourceConfig_tagsSameAccount(rName string) string {
 	return acctest.ConfigCompose(testAccTransitGatewayPeeringAttachmentConfig_tags1(rName, "Name", rName), `
 data "aws_ec2_transit_gateway_peering_attachment" "test" {
@@ -0,0 +1,150 @@
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+func DataSourceTransitGatewayPeeringAttachments() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTransitGatewayPeeringAttachmentsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"attachments": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"age_seconds": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"orphaned": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"peer_account_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"filter": DataSourceFiltersSchema(),
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"orphaned_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"pending_acceptance_threshold_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      86400,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayPeeringAttachmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	input := &ec2.DescribeTransitGatewayPeeringAttachmentsInput{}
+
+	input.Filters = append(input.Filters, BuildFiltersDataSource(
+		d.Get("filter").(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := FindTransitGatewayPeeringAttachments(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Peering Attachments: %s", err)
+	}
+
+	threshold := time.Duration(d.Get("pending_acceptance_threshold_seconds").(int)) * time.Second
+	attachments := flattenTransitGatewayPeeringAttachmentSummaries(output, meta.(*conns.AWSClient).AccountID, meta.(*conns.AWSClient).Region, time.Now(), threshold)
+
+	var attachmentIDs, orphanedIDs []string
+
+	for _, v := range attachments {
+		tfMap := v.(map[string]interface{})
+		attachmentIDs = append(attachmentIDs, tfMap["id"].(string))
+
+		if tfMap["orphaned"].(bool) {
+			orphanedIDs = append(orphanedIDs, tfMap["id"].(string))
+		}
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("attachments", attachments); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attachments: %s", err)
+	}
+	d.Set("ids", attachmentIDs)
+	d.Set("orphaned_ids", orphanedIDs)
+
+	return diags
+}
+
+// flattenTransitGatewayPeeringAttachmentSummaries returns a summary of each attachment, including its age at the specified time.
+// An attachment is orphaned if it has been pending acceptance for longer than the specified threshold.
+func flattenTransitGatewayPeeringAttachmentSummaries(apiObjects []*ec2.TransitGatewayPeeringAttachment, accountID, region string, now time.Time, threshold time.Duration) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var age time.Duration
+		if v := apiObject.CreationTime; v != nil && now.After(*v) {
+			age = now.Sub(*v)
+		}
+
+		state := aws.StringValue(apiObject.State)
+		tfMap := map[string]interface{}{
+			"age_seconds": int(age / time.Second),
+			"id":          aws.StringValue(apiObject.TransitGatewayAttachmentId),
+			"orphaned":    state == ec2.TransitGatewayAttachmentStatePendingAcceptance && age > threshold,
+			"state":       state,
+		}
+
+		if apiObject.AccepterTgwInfo != nil && apiObject.RequesterTgwInfo != nil {
+			_, peer := transitGatewayPeeringAttachmentLocalAndPeer(apiObject, accountID, region)
+			tfMap["peer_account_id"] = aws.StringValue(peer.OwnerId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
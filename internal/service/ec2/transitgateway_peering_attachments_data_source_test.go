@@ -0,0 +1,128 @@
+package ec2_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+func TestFlattenTransitGatewayPeeringAttachmentSummaries(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accountID     = "123456789012"
+		peerAccountID = "210987654321"
+		region        = "us-west-2" //lintignore:AWSAT003
+		peerRegion    = "us-east-1" //lintignore:AWSAT003
+	)
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	local := &ec2.PeeringTgwInfo{OwnerId: aws.String(accountID), Region: aws.String(region)}
+	peer := &ec2.PeeringTgwInfo{OwnerId: aws.String(peerAccountID), Region: aws.String(peerRegion)}
+
+	apiObjects := []*ec2.TransitGatewayPeeringAttachment{
+		{
+			AccepterTgwInfo:            peer,
+			CreationTime:               aws.Time(now.Add(-48 * time.Hour)),
+			RequesterTgwInfo:           local,
+			State:                      aws.String(ec2.TransitGatewayAttachmentStatePendingAcceptance),
+			TransitGatewayAttachmentId: aws.String("tgw-attach-11111111"),
+		},
+		{
+			AccepterTgwInfo:            peer,
+			CreationTime:               aws.Time(now.Add(-time.Hour)),
+			RequesterTgwInfo:           local,
+			State:                      aws.String(ec2.TransitGatewayAttachmentStatePendingAcceptance),
+			TransitGatewayAttachmentId: aws.String("tgw-attach-22222222"),
+		},
+		{
+			AccepterTgwInfo:            local,
+			CreationTime:               aws.Time(now.Add(-72 * time.Hour)),
+			RequesterTgwInfo:           peer,
+			State:                      aws.String(ec2.TransitGatewayAttachmentStateAvailable),
+			TransitGatewayAttachmentId: aws.String("tgw-attach-33333333"),
+		},
+		nil,
+	}
+
+	got := tfec2.FlattenTransitGatewayPeeringAttachmentSummaries(apiObjects, accountID, region, now, 24*time.Hour)
+	expected := []interface{}{
+		map[string]interface{}{
+			"age_seconds":     172800,
+			"id":              "tgw-attach-11111111",
+			"orphaned":        true,
+			"peer_account_id": peerAccountID,
+			"state":           ec2.TransitGatewayAttachmentStatePendingAcceptance,
+		},
+		map[string]interface{}{
+			"age_seconds":     3600,
+			"id":              "tgw-attach-22222222",
+			"orphaned":        false,
+			"peer_account_id": peerAccountID,
+			"state":           ec2.TransitGatewayAttachmentStatePendingAcceptance,
+		},
+		map[string]interface{}{
+			"age_seconds":     259200,
+			"id":              "tgw-attach-33333333",
+			"orphaned":        false,
+			"peer_account_id": peerAccountID,
+			"state":           ec2.TransitGatewayAttachmentStateAvailable,
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %#v, expected %#v", got, expected)
+	}
+}
+
+func testAccTransitGatewayPeeringAttachmentsDataSource_orphaned(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_peering_attachments.test"
+	resourceName := "aws_ec2_transit_gateway_peering_attachment.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTransitGateway(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		CheckDestroy:             testAccCheckTransitGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayPeeringAttachmentsDataSourceConfig_orphaned(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "attachments.0.age_seconds"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.0.orphaned", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "attachments.0.peer_account_id", resourceName, "peer_account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "attachments.0.state", ec2.TransitGatewayAttachmentStatePendingAcceptance),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "orphaned_ids.0", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayPeeringAttachmentsDataSourceConfig_orphaned(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayPeeringAttachmentConfig_sameAccount(rName), `
+data "aws_ec2_transit_gateway_peering_attachments" "test" {
+  pending_acceptance_threshold_seconds = 0
+
+  filter {
+    name   = "transit-gateway-attachment-id"
+    values = [aws_ec2_transit_gateway_peering_attachment.test.id]
+  }
+}
+`)
+}
@@ -1,18 +1,27 @@
 package ec2
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -30,13 +39,26 @@ func ResourceTransitGatewayRouteTable() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceTransitGatewayRouteTableCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"association_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"default_association_route_table": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -45,6 +67,72 @@ func ResourceTransitGatewayRouteTable() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fetch_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"has_blackhole_routes": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_default_association": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_default_propagation": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"propagation_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"previous_default_association_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"route": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blackhole": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"destination_cidr_block": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						"transit_gateway_attachment_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+				Set: transitGatewayRouteTableRouteHash,
+			},
+			"set_as_default_association": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 			"transit_gateway_id": {
@@ -53,15 +141,74 @@ func ResourceTransitGatewayRouteTable() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"transit_gateway_owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+func resourceTransitGatewayRouteTableCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if config := diff.GetRawConfig(); !config.IsNull() && config.IsKnown() {
+		if err := transitGatewayRouteTableValidateRouteTargets(config.GetAttr("route")); err != nil {
+			return err
+		}
+	}
+
+	if diff.Id() != "" {
+		if keys := transitGatewayRouteTableUnexpectedUpdateKeys(transitGatewayRouteTableSchema(), diff.GetChangedKeysPrefix("")); len(keys) > 0 {
+			return fmt.Errorf("EC2 Transit Gateway Route Table (%s) cannot be updated in place, unexpected change to: %s", diff.Id(), strings.Join(keys, ", "))
+		}
+	}
+
+	if diff.Id() != "" && diff.HasChange("fetch_details") {
+		for _, key := range transitGatewayRouteTableDetailKeys {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if diff.Id() != "" && diff.HasChange("set_as_default_association") {
+		keys := []string{"default_association_route_table", "previous_default_association_route_table_id"}
+
+		if diff.Get("fetch_details").(bool) {
+			keys = append(keys, "is_default_association")
+		}
+
+		for _, key := range keys {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	if tftags.New(diff.Get("tags").(map[string]interface{})).KeyExists(transitGatewayRouteTableDescriptionTagKey) {
+		return fmt.Errorf("the %q tag is reserved, use description instead", transitGatewayRouteTableDescriptionTagKey)
+	}
+
+	if keys := defaultTagsConflictingKeys(meta.(*conns.AWSClient).DefaultTagsConfig, tftags.New(diff.Get("tags").(map[string]interface{}))); len(keys) > 0 {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table tags override provider default_tags with different values: %s", strings.Join(keys, ", "))
+	}
+
+	return nil
+}
+
 func resourceTransitGatewayRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+	configuredTags := tftags.New(d.Get("tags").(map[string]interface{}))
+	tags := defaultTagsConfig.MergeTags(configuredTags)
+
+	if keys := defaultTagsConflictingKeys(defaultTagsConfig, configuredTags); len(keys) > 0 {
+		diags = sdkdiag.AppendWarningf(diags, "EC2 Transit Gateway Route Table tags (%s) override provider default_tags with different values", strings.Join(keys, ", "))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		tags = tags.Merge(tftags.New(map[string]string{transitGatewayRouteTableDescriptionTagKey: v.(string)}))
+	}
 
 	input := &ec2.CreateTransitGatewayRouteTableInput{
 		TransitGatewayId:  aws.String(d.Get("transit_gateway_id").(string)),
@@ -77,10 +224,22 @@ func resourceTransitGatewayRouteTableCreate(ctx context.Context, d *schema.Resou
 
 	d.SetId(aws.StringValue(output.TransitGatewayRouteTable.TransitGatewayRouteTableId))
 
-	if _, err := WaitTransitGatewayRouteTableCreated(ctx, conn, d.Id()); err != nil {
+	if _, err := WaitTransitGatewayRouteTableAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Route Table (%s) create: %s", d.Id(), err)
 	}
 
+	if d.Get("set_as_default_association").(bool) {
+		if err := transitGatewayRouteTableSetDefaultAssociation(ctx, conn, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if v := d.Get("route").(*schema.Set).List(); len(v) > 0 {
+		if err := transitGatewayRouteTableUpdateRoutes(ctx, conn, d.Id(), nil, v); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceTransitGatewayRouteTableRead(ctx, d, meta)...)
 }
 
@@ -102,19 +261,48 @@ func resourceTransitGatewayRouteTableRead(ctx context.Context, d *schema.Resourc
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table (%s): %s", d.Id(), err)
 	}
 
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   ec2.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("transit-gateway-route-table/%s", d.Id()),
-	}.String()
-	d.Set("arn", arn)
+	d.Set("arn", transitGatewayRouteTableARN(meta.(*conns.AWSClient).Partition, meta.(*conns.AWSClient).Region, meta.(*conns.AWSClient).AccountID, d.Id()))
 	d.Set("default_association_route_table", transitGatewayRouteTable.DefaultAssociationRouteTable)
 	d.Set("default_propagation_route_table", transitGatewayRouteTable.DefaultPropagationRouteTable)
+	d.Set("state", transitGatewayRouteTable.State)
 	d.Set("transit_gateway_id", transitGatewayRouteTable.TransitGatewayId)
 
-	tags := KeyValueTags(transitGatewayRouteTable.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+	// The transit gateway's owner is not on the route table, so the transit gateway is always read.
+	// It is informational, so a failure to read it is a warning rather than failing the refresh.
+	transitGatewayID := aws.StringValue(transitGatewayRouteTable.TransitGatewayId)
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, transitGatewayID)
+
+	if err != nil {
+		diags = sdkdiag.AppendWarningf(diags, "reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+		d.Set("transit_gateway_owner_id", nil)
+	} else {
+		d.Set("transit_gateway_owner_id", transitGateway.OwnerId)
+	}
+
+	// Details take more API calls, so they are only read when requested.
+	if d.Get("fetch_details").(bool) {
+		diags = append(diags, transitGatewayRouteTableReadDetails(ctx, conn, d, transitGateway)...)
+	} else {
+		for _, key := range transitGatewayRouteTableDetailKeys {
+			d.Set(key, nil)
+		}
+	}
+
+	// Only the inline routes are read, so that routes managed by aws_ec2_transit_gateway_route resources are left alone.
+	if v := d.Get("route").(*schema.Set).List(); len(v) > 0 {
+		routes, err := findTransitGatewayRouteTableStaticRoutesByDestinations(ctx, conn, d.Id(), transitGatewayRouteTableRouteDestinations(v))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table (%s) routes: %s", d.Id(), err)
+		}
+
+		if err := d.Set("route", flattenTransitGatewayRouteTableRoutes(routes, v)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting route: %s", err)
+		}
+	}
+
+	description, tags := transitGatewayRouteTableDescriptionFromTags(KeyValueTags(transitGatewayRouteTable.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig))
+	d.Set("description", description)
 
 	//lintignore:AWSR002
 	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
@@ -132,21 +320,75 @@ func resourceTransitGatewayRouteTableUpdate(ctx context.Context, d *schema.Resou
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
+	if d.HasChange("set_as_default_association") {
+		var err error
+
+		if d.Get("set_as_default_association").(bool) {
+			err = transitGatewayRouteTableSetDefaultAssociation(ctx, conn, d, d.Timeout(schema.TimeoutUpdate))
+		} else {
+			err = transitGatewayRouteTableRevertDefaultAssociation(ctx, conn, d, d.Timeout(schema.TimeoutUpdate))
+		}
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.HasChange("route") {
+		o, n := d.GetChange("route")
+
+		if err := transitGatewayRouteTableUpdateRoutes(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.HasChange("description") {
+		o, n := d.GetChange("description")
+		oldTags, newTags := map[string]string{}, map[string]string{}
+
+		if v := o.(string); v != "" {
+			oldTags[transitGatewayRouteTableDescriptionTagKey] = v
+		}
+		if v := n.(string); v != "" {
+			newTags[transitGatewayRouteTableDescriptionTagKey] = v
+		}
+
+		if err := UpdateTags(ctx, conn, d.Id(), oldTags, newTags); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Route Table (%s) description: %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("tags_all") {
 		o, n := d.GetChange("tags_all")
 
 		if err := UpdateTags(ctx, conn, d.Id(), o, n); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Transit Gateway Route Table (%s) tags: %s", d.Id(), err)
 		}
+
+		if keys := defaultTagsConflictingKeys(meta.(*conns.AWSClient).DefaultTagsConfig, tftags.New(d.Get("tags").(map[string]interface{}))); len(keys) > 0 {
+			diags = sdkdiag.AppendWarningf(diags, "EC2 Transit Gateway Route Table (%s) tags (%s) override provider default_tags with different values", d.Id(), strings.Join(keys, ", "))
+		}
 	}
 
-	return diags
+	return append(diags, resourceTransitGatewayRouteTableRead(ctx, d, meta)...)
 }
 
 func resourceTransitGatewayRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn()
 
+	if d.Get("set_as_default_association").(bool) {
+		if err := transitGatewayRouteTableRevertDefaultAssociation(ctx, conn, d, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	if d.Get("force_destroy").(bool) {
+		if err := transitGatewayRouteTableRemoveAttachments(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Route Table (%s): %s", d.Id(), err)
+		}
+	}
+
 	log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route Table: %s", d.Id())
 	_, err := conn.DeleteTransitGatewayRouteTableWithContext(ctx, &ec2.DeleteTransitGatewayRouteTableInput{
 		TransitGatewayRouteTableId: aws.String(d.Id()),
@@ -160,9 +402,729 @@ func resourceTransitGatewayRouteTableDelete(ctx context.Context, d *schema.Resou
 		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Route Table (%s): %s", d.Id(), err)
 	}
 
-	if _, err := WaitTransitGatewayRouteTableDeleted(ctx, conn, d.Id()); err != nil {
+	if _, err := WaitTransitGatewayRouteTableDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Route Table (%s) delete: %s", d.Id(), err)
 	}
 
 	return diags
 }
+
+// transitGatewayRouteTableUpdatableKeys are the arguments that are applied by resourceTransitGatewayRouteTableUpdate.
+var transitGatewayRouteTableUpdatableKeys = map[string]bool{
+	"description":                true,
+	"fetch_details":              true,
+	"force_destroy":              true,
+	"route":                      true,
+	"set_as_default_association": true,
+	"tags":                       true,
+	"tags_all":                   true,
+}
+
+var (
+	transitGatewayRouteTableSchemaOnce sync.Once
+	transitGatewayRouteTableSchemaMap  map[string]*schema.Schema
+)
+
+// transitGatewayRouteTableSchema returns the resource schema, which is built once rather than on every diff.
+func transitGatewayRouteTableSchema() map[string]*schema.Schema {
+	transitGatewayRouteTableSchemaOnce.Do(func() {
+		transitGatewayRouteTableSchemaMap = ResourceTransitGatewayRouteTable().Schema
+	})
+
+	return transitGatewayRouteTableSchemaMap
+}
+
+// transitGatewayRouteTableUnexpectedUpdateKeys returns the sorted top-level arguments among the changed keys that
+// would be updated in place but that resourceTransitGatewayRouteTableUpdate does not apply.
+// Computed-only attributes and arguments that force a new resource are never updated in place.
+func transitGatewayRouteTableUnexpectedUpdateKeys(schemaMap map[string]*schema.Schema, changedKeys []string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+
+	for _, k := range changedKeys {
+		k = strings.SplitN(k, ".", 2)[0]
+
+		if seen[k] || transitGatewayRouteTableUpdatableKeys[k] {
+			continue
+		}
+		seen[k] = true
+
+		if v, ok := schemaMap[k]; !ok || v.ForceNew || !(v.Optional || v.Required) {
+			continue
+		}
+
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// transitGatewayRouteTableSetDefaultAssociation makes the route table the transit gateway's default association route table.
+// Any route table that is already the default is replaced and recorded so that it can be restored later.
+func transitGatewayRouteTableSetDefaultAssociation(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData, timeout time.Duration) error {
+	transitGatewayID := d.Get("transit_gateway_id").(string)
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, transitGatewayID)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Transit Gateway (%s): %w", transitGatewayID, err)
+	}
+
+	var previousID string
+	if transitGateway.Options != nil {
+		previousID = aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId)
+	}
+
+	if previousID == d.Id() {
+		return nil
+	}
+
+	if previousID != "" {
+		log.Printf("[INFO] Replacing EC2 Transit Gateway (%s) default association route table (%s) with %s", transitGatewayID, previousID, d.Id())
+	}
+
+	if err := modifyTransitGatewayAssociationDefaultRouteTable(ctx, conn, transitGatewayID, d.Id(), timeout); err != nil {
+		return fmt.Errorf("setting EC2 Transit Gateway Route Table (%s) as default association route table: %w", d.Id(), err)
+	}
+
+	d.Set("previous_default_association_route_table_id", previousID)
+
+	return nil
+}
+
+// transitGatewayRouteTableRevertDefaultAssociation restores the transit gateway's previous default association route table.
+// Nothing is done if the route table is no longer the default or if the previous default route table no longer exists.
+func transitGatewayRouteTableRevertDefaultAssociation(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData, timeout time.Duration) error {
+	transitGatewayID := d.Get("transit_gateway_id").(string)
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, transitGatewayID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Transit Gateway (%s): %w", transitGatewayID, err)
+	}
+
+	if transitGateway.Options == nil || aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId) != d.Id() {
+		return nil
+	}
+
+	previousID := d.Get("previous_default_association_route_table_id").(string)
+
+	if previousID == "" {
+		log.Printf("[WARN] EC2 Transit Gateway (%s) has no previous default association route table to restore", transitGatewayID)
+		return nil
+	}
+
+	if _, err := FindTransitGatewayRouteTableByID(ctx, conn, previousID); tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Route Table (%s) not found, not restoring as default association route table", previousID)
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("reading EC2 Transit Gateway Route Table (%s): %w", previousID, err)
+	}
+
+	if err := modifyTransitGatewayAssociationDefaultRouteTable(ctx, conn, transitGatewayID, previousID, timeout); err != nil {
+		return fmt.Errorf("restoring EC2 Transit Gateway (%s) default association route table (%s): %w", transitGatewayID, previousID, err)
+	}
+
+	d.Set("previous_default_association_route_table_id", "")
+
+	return nil
+}
+
+func modifyTransitGatewayAssociationDefaultRouteTable(ctx context.Context, conn *ec2.EC2, transitGatewayID, transitGatewayRouteTableID string, timeout time.Duration) error {
+	input := &ec2.ModifyTransitGatewayInput{
+		Options: &ec2.ModifyTransitGatewayOptions{
+			AssociationDefaultRouteTableId: aws.String(transitGatewayRouteTableID),
+		},
+		TransitGatewayId: aws.String(transitGatewayID),
+	}
+
+	if _, err := conn.ModifyTransitGatewayWithContext(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := WaitTransitGatewayUpdated(ctx, conn, transitGatewayID, timeout); err != nil {
+		return fmt.Errorf("waiting for EC2 Transit Gateway (%s) update: %w", transitGatewayID, err)
+	}
+
+	return nil
+}
+
+// transitGatewayRouteTableDetailKeys are the attributes that are only read when fetch_details is enabled.
+var transitGatewayRouteTableDetailKeys = []string{
+	"association_count",
+	"has_blackhole_routes",
+	"is_default_association",
+	"is_default_propagation",
+	"propagation_count",
+}
+
+// transitGatewayRouteTableReadDetails reads the attributes that are only read when fetch_details is enabled.
+// The details are informational, so an attribute that cannot be read is left unset with a warning
+// rather than failing the refresh. The default route table flags are read from the specified transit gateway,
+// which is nil if it could not be read.
+func transitGatewayRouteTableReadDetails(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData, transitGateway *ec2.TransitGateway) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v, err := transitGatewayRouteTableAssociationCount(ctx, conn, d.Id()); err != nil {
+		diags = sdkdiag.AppendWarningf(diags, "reading EC2 Transit Gateway Route Table (%s) associations: %s", d.Id(), err)
+		d.Set("association_count", nil)
+	} else {
+		d.Set("association_count", v)
+	}
+
+	if v, err := transitGatewayRouteTableHasBlackholeRoutes(ctx, conn, d.Id()); err != nil {
+		diags = sdkdiag.AppendWarningf(diags, "reading EC2 Transit Gateway Route Table (%s) blackhole routes: %s", d.Id(), err)
+		d.Set("has_blackhole_routes", nil)
+	} else {
+		d.Set("has_blackhole_routes", v)
+	}
+
+	if v, err := transitGatewayRouteTablePropagationCount(ctx, conn, d.Id()); err != nil {
+		diags = sdkdiag.AppendWarningf(diags, "reading EC2 Transit Gateway Route Table (%s) propagations: %s", d.Id(), err)
+		d.Set("propagation_count", nil)
+	} else {
+		d.Set("propagation_count", v)
+	}
+
+	if transitGateway == nil {
+		d.Set("is_default_association", nil)
+		d.Set("is_default_propagation", nil)
+	} else {
+		isDefaultAssociation, isDefaultPropagation := transitGatewayDefaultRouteTable(transitGateway, d.Id())
+		d.Set("is_default_association", isDefaultAssociation)
+		d.Set("is_default_propagation", isDefaultPropagation)
+	}
+
+	return diags
+}
+
+// transitGatewayRouteTableAssociationCount returns the number of attachments associated with the specified route table.
+// Associations are only counted, not collected, as a route table may have many of them.
+func transitGatewayRouteTableAssociationCount(ctx context.Context, conn *ec2.EC2, id string) (int, error) {
+	input := &ec2.GetTransitGatewayRouteTableAssociationsInput{
+		TransitGatewayRouteTableId: aws.String(id),
+	}
+	var count int
+
+	err := conn.GetTransitGatewayRouteTableAssociationsPagesWithContext(ctx, input, func(page *ec2.GetTransitGatewayRouteTableAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Associations {
+			if v != nil && aws.StringValue(v.State) == ec2.TransitGatewayAssociationStateAssociated {
+				count++
+			}
+		}
+
+		return !lastPage
+	})
+
+	return count, err
+}
+
+// transitGatewayRouteTablePropagationCount returns the number of attachments propagating routes to the specified route table.
+// Propagations are only counted, not collected, as a route table may have many of them.
+func transitGatewayRouteTablePropagationCount(ctx context.Context, conn *ec2.EC2, id string) (int, error) {
+	input := &ec2.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: aws.String(id),
+	}
+	var count int
+
+	err := conn.GetTransitGatewayRouteTablePropagationsPagesWithContext(ctx, input, func(page *ec2.GetTransitGatewayRouteTablePropagationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TransitGatewayRouteTablePropagations {
+			if v != nil && aws.StringValue(v.State) == ec2.TransitGatewayPropagationStateEnabled {
+				count++
+			}
+		}
+
+		return !lastPage
+	})
+
+	return count, err
+}
+
+// transitGatewayRouteTableForceDestroyConcurrency is the maximum number of associations and propagations
+// that are removed at the same time when a route table is force destroyed.
+const transitGatewayRouteTableForceDestroyConcurrency = 10
+
+// transitGatewayRouteTableRemoveAttachments disassociates all attachments from the specified route table
+// and disables all propagations to it, waiting for each to be removed. Removals run concurrently, up to
+// transitGatewayRouteTableForceDestroyConcurrency at a time, and all of their errors are returned.
+func transitGatewayRouteTableRemoveAttachments(ctx context.Context, conn *ec2.EC2, id string) error {
+	associations, err := FindTransitGatewayRouteTableAssociations(ctx, conn, &ec2.GetTransitGatewayRouteTableAssociationsInput{
+		TransitGatewayRouteTableId: aws.String(id),
+	})
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing EC2 Transit Gateway Route Table Associations: %w", err)
+	}
+
+	propagations, err := FindTransitGatewayRouteTablePropagations(ctx, conn, &ec2.GetTransitGatewayRouteTablePropagationsInput{
+		TransitGatewayRouteTableId: aws.String(id),
+	})
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("listing EC2 Transit Gateway Route Table Propagations: %w", err)
+	}
+
+	var g multierror.Group
+	sem := make(chan struct{}, transitGatewayRouteTableForceDestroyConcurrency)
+	goBounded := func(f func() error) {
+		g.Go(func() error {
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			return f()
+		})
+	}
+
+	for _, v := range associations {
+		if state := aws.StringValue(v.State); state == ec2.TransitGatewayAssociationStateDisassociated {
+			continue
+		}
+
+		attachmentID := aws.StringValue(v.TransitGatewayAttachmentId)
+		goBounded(func() error {
+			associationID := TransitGatewayRouteTableAssociationCreateResourceID(id, attachmentID)
+
+			log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route Table Association: %s", associationID)
+			_, err := conn.DisassociateTransitGatewayRouteTableWithContext(ctx, &ec2.DisassociateTransitGatewayRouteTableInput{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				TransitGatewayRouteTableId: aws.String(id),
+			})
+
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting EC2 Transit Gateway Route Table Association (%s): %w", associationID, err)
+			}
+
+			if _, err := WaitTransitGatewayRouteTableAssociationDeleted(ctx, conn, id, attachmentID); err != nil {
+				return fmt.Errorf("waiting for EC2 Transit Gateway Route Table Association (%s) delete: %w", associationID, err)
+			}
+
+			return nil
+		})
+	}
+
+	for _, v := range propagations {
+		if state := aws.StringValue(v.State); state == ec2.TransitGatewayPropagationStateDisabled {
+			continue
+		}
+
+		attachmentID := aws.StringValue(v.TransitGatewayAttachmentId)
+		goBounded(func() error {
+			propagationID := TransitGatewayRouteTablePropagationCreateResourceID(id, attachmentID)
+
+			log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route Table Propagation: %s", propagationID)
+			_, err := conn.DisableTransitGatewayRouteTablePropagationWithContext(ctx, &ec2.DisableTransitGatewayRouteTablePropagationInput{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				TransitGatewayRouteTableId: aws.String(id),
+			})
+
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteTableIDNotFound) {
+				return nil
+			}
+
+			if err != nil {
+				return fmt.Errorf("deleting EC2 Transit Gateway Route Table Propagation (%s): %w", propagationID, err)
+			}
+
+			if _, err := WaitTransitGatewayRouteTablePropagationDeleted(ctx, conn, id, attachmentID); err != nil {
+				return fmt.Errorf("waiting for EC2 Transit Gateway Route Table Propagation (%s) delete: %w", propagationID, err)
+			}
+
+			return nil
+		})
+	}
+
+	return g.Wait().ErrorOrNil()
+}
+
+// transitGatewayRouteTableHasBlackholeRoutes reports whether the specified route table has any blackhole routes.
+// Only whether such a route exists matters, so a single page of results is requested.
+func transitGatewayRouteTableHasBlackholeRoutes(ctx context.Context, conn *ec2.EC2, id string) (bool, error) {
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		Filters: BuildAttributeFilterList(map[string]string{
+			"state": ec2.TransitGatewayRouteStateBlackhole,
+		}),
+		MaxResults:                 aws.Int64(5),
+		TransitGatewayRouteTableId: aws.String(id),
+	}
+
+	output, err := conn.SearchTransitGatewayRoutesWithContext(ctx, input)
+
+	if err != nil {
+		return false, err
+	}
+
+	return output != nil && len(output.Routes) > 0, nil
+}
+
+// transitGatewayRouteTableValidateRouteTargets checks that each configured inline route sets exactly one of
+// transit_gateway_attachment_id and blackhole. Routes whose values are not yet known are not checked.
+func transitGatewayRouteTableValidateRouteTargets(routes cty.Value) error {
+	if routes.IsNull() || !routes.IsKnown() || !routes.CanIterateElements() {
+		return nil
+	}
+
+	for it := routes.ElementIterator(); it.Next(); {
+		_, route := it.Element()
+
+		if route.IsNull() || !route.IsKnown() {
+			continue
+		}
+
+		attachmentID, blackhole := route.GetAttr("transit_gateway_attachment_id"), route.GetAttr("blackhole")
+
+		if !blackhole.IsKnown() {
+			continue
+		}
+
+		hasAttachmentID := !attachmentID.IsNull() && (!attachmentID.IsKnown() || attachmentID.AsString() != "")
+		isBlackhole := !blackhole.IsNull() && blackhole.True()
+
+		var destination string
+		if v := route.GetAttr("destination_cidr_block"); v.IsKnown() && !v.IsNull() {
+			destination = v.AsString()
+		}
+
+		if hasAttachmentID == isBlackhole {
+			return fmt.Errorf("route (%s): exactly one of transit_gateway_attachment_id or blackhole must be set", destination)
+		}
+	}
+
+	return nil
+}
+
+// transitGatewayRouteTableRouteKey returns the key that identifies an inline route's destination.
+// IPv4 and IPv6 routes are separate in a route table, so the key includes the address family.
+// Without it an IPv4-mapped IPv6 CIDR block such as ::ffff:10.0.0.0/104 would be the same route as 10.0.0.0/8.
+func transitGatewayRouteTableRouteKey(destination string) string {
+	family := "ipv4"
+
+	if strings.Contains(destination, ":") {
+		family = "ipv6"
+	}
+
+	return fmt.Sprintf("%s-%s", family, verify.CanonicalCIDRBlock(destination))
+}
+
+// transitGatewayRouteTableRouteHash hashes an inline route. Destinations are canonicalized so that
+// equivalent IPv6 CIDR blocks are the same route.
+func transitGatewayRouteTableRouteHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+
+	if v, ok := m["destination_cidr_block"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", transitGatewayRouteTableRouteKey(v)))
+	}
+
+	if v, ok := m["transit_gateway_attachment_id"].(string); ok {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
+
+	if v, ok := m["blackhole"].(bool); ok {
+		buf.WriteString(fmt.Sprintf("%t-", v))
+	}
+
+	return create.StringHashcode(buf.String())
+}
+
+// transitGatewayRouteTableRouteDestinations returns the canonical destinations of the specified inline routes.
+func transitGatewayRouteTableRouteDestinations(tfList []interface{}) []string {
+	var destinations []string
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		destination := tfMap["destination_cidr_block"].(string)
+
+		// Keep an IPv6 destination in IPv6 form when its canonical form is an IPv4 CIDR block.
+		if v := verify.CanonicalCIDRBlock(destination); strings.Contains(v, ":") == strings.Contains(destination, ":") {
+			destination = v
+		}
+
+		destinations = append(destinations, destination)
+	}
+
+	return destinations
+}
+
+// transitGatewayRouteTableRoutesDiff returns the inline routes to create and to replace and the destinations of the routes
+// to delete when moving from the old to the new routes. Routes are identified by their address family and canonical destination,
+// so a route whose target changes is replaced rather than deleted and created again.
+func transitGatewayRouteTableRoutesDiff(o, n []interface{}) (add, replace []map[string]interface{}, remove []string) {
+	oldRoutes := make(map[string]map[string]interface{}, len(o))
+
+	for _, tfMapRaw := range o {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		oldRoutes[transitGatewayRouteTableRouteKey(tfMap["destination_cidr_block"].(string))] = tfMap
+	}
+
+	newKeys := make(map[string]bool, len(n))
+
+	for _, tfMapRaw := range n {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		key := transitGatewayRouteTableRouteKey(tfMap["destination_cidr_block"].(string))
+		newKeys[key] = true
+
+		if old, ok := oldRoutes[key]; !ok {
+			add = append(add, tfMap)
+		} else if old["transit_gateway_attachment_id"].(string) != tfMap["transit_gateway_attachment_id"].(string) || old["blackhole"].(bool) != tfMap["blackhole"].(bool) {
+			replace = append(replace, tfMap)
+		}
+	}
+
+	for key, tfMap := range oldRoutes {
+		if !newKeys[key] {
+			remove = append(remove, tfMap["destination_cidr_block"].(string))
+		}
+	}
+
+	sort.Strings(remove)
+
+	return add, replace, remove
+}
+
+// transitGatewayRouteTableUpdateRoutes reconciles the route table's inline routes when moving from the old to the new routes.
+// Routes that are not inline routes are not affected.
+func transitGatewayRouteTableUpdateRoutes(ctx context.Context, conn *ec2.EC2, id string, o, n []interface{}) error {
+	add, replace, remove := transitGatewayRouteTableRoutesDiff(o, n)
+
+	for _, destination := range remove {
+		routeID := TransitGatewayRouteCreateResourceID(id, destination)
+
+		log.Printf("[DEBUG] Deleting EC2 Transit Gateway Route: %s", routeID)
+		_, err := conn.DeleteTransitGatewayRouteWithContext(ctx, &ec2.DeleteTransitGatewayRouteInput{
+			DestinationCidrBlock:       aws.String(destination),
+			TransitGatewayRouteTableId: aws.String(id),
+		})
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting EC2 Transit Gateway Route (%s): %w", routeID, err)
+		}
+
+		if _, err := WaitTransitGatewayRouteDeleted(ctx, conn, id, destination); err != nil {
+			return fmt.Errorf("waiting for EC2 Transit Gateway Route (%s) delete: %w", routeID, err)
+		}
+	}
+
+	for _, tfMap := range replace {
+		destination := tfMap["destination_cidr_block"].(string)
+		routeID := TransitGatewayRouteCreateResourceID(id, destination)
+		input := &ec2.ReplaceTransitGatewayRouteInput{
+			Blackhole:                  aws.Bool(tfMap["blackhole"].(bool)),
+			DestinationCidrBlock:       aws.String(destination),
+			TransitGatewayRouteTableId: aws.String(id),
+		}
+
+		if v := tfMap["transit_gateway_attachment_id"].(string); v != "" {
+			input.TransitGatewayAttachmentId = aws.String(v)
+		}
+
+		log.Printf("[DEBUG] Replacing EC2 Transit Gateway Route: %s", input)
+		if _, err := conn.ReplaceTransitGatewayRouteWithContext(ctx, input); err != nil {
+			return fmt.Errorf("replacing EC2 Transit Gateway Route (%s): %w", routeID, err)
+		}
+
+		if _, err := WaitTransitGatewayRouteCreated(ctx, conn, id, destination); err != nil {
+			return fmt.Errorf("waiting for EC2 Transit Gateway Route (%s) replace: %w", routeID, err)
+		}
+	}
+
+	for _, tfMap := range add {
+		destination := tfMap["destination_cidr_block"].(string)
+		routeID := TransitGatewayRouteCreateResourceID(id, destination)
+		input := &ec2.CreateTransitGatewayRouteInput{
+			Blackhole:                  aws.Bool(tfMap["blackhole"].(bool)),
+			DestinationCidrBlock:       aws.String(destination),
+			TransitGatewayRouteTableId: aws.String(id),
+		}
+
+		if v := tfMap["transit_gateway_attachment_id"].(string); v != "" {
+			input.TransitGatewayAttachmentId = aws.String(v)
+		}
+
+		log.Printf("[DEBUG] Creating EC2 Transit Gateway Route: %s", input)
+		if _, err := conn.CreateTransitGatewayRouteWithContext(ctx, input); err != nil {
+			return fmt.Errorf("creating EC2 Transit Gateway Route (%s): %w", routeID, err)
+		}
+
+		if _, err := WaitTransitGatewayRouteCreated(ctx, conn, id, destination); err != nil {
+			return fmt.Errorf("waiting for EC2 Transit Gateway Route (%s) create: %w", routeID, err)
+		}
+	}
+
+	return nil
+}
+
+// findTransitGatewayRouteTableStaticRoutesByDestinations returns the route table's static routes to the specified destinations.
+func findTransitGatewayRouteTableStaticRoutesByDestinations(ctx context.Context, conn *ec2.EC2, id string, destinations []string) ([]*ec2.TransitGatewayRoute, error) {
+	input := &ec2.SearchTransitGatewayRoutesInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("route-search.exact-match"),
+				Values: aws.StringSlice(destinations),
+			},
+			{
+				Name:   aws.String("type"),
+				Values: aws.StringSlice([]string{ec2.TransitGatewayRouteTypeStatic}),
+			},
+		},
+		MaxResults:                 aws.Int64(1000),
+		TransitGatewayRouteTableId: aws.String(id),
+	}
+
+	output, err := conn.SearchTransitGatewayRoutesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	var routes []*ec2.TransitGatewayRoute
+
+	for _, v := range output.Routes {
+		if v == nil {
+			continue
+		}
+
+		if state := aws.StringValue(v.State); state == ec2.TransitGatewayRouteStateDeleted || state == ec2.TransitGatewayRouteStateDeleting {
+			continue
+		}
+
+		routes = append(routes, v)
+	}
+
+	return routes, nil
+}
+
+// flattenTransitGatewayRouteTableRoutes flattens the specified routes into inline routes.
+// The configured destination is kept if it is equivalent to the route's, e.g. an IPv6 CIDR block in a different format.
+func flattenTransitGatewayRouteTableRoutes(apiObjects []*ec2.TransitGatewayRoute, configured []interface{}) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		destination := aws.StringValue(apiObject.DestinationCidrBlock)
+
+		for _, tfMapRaw := range configured {
+			if tfMap, ok := tfMapRaw.(map[string]interface{}); ok && transitGatewayRouteTableRouteKey(tfMap["destination_cidr_block"].(string)) == transitGatewayRouteTableRouteKey(destination) {
+				destination = tfMap["destination_cidr_block"].(string)
+				break
+			}
+		}
+
+		tfMap := map[string]interface{}{
+			"blackhole":                     true,
+			"destination_cidr_block":        destination,
+			"transit_gateway_attachment_id": "",
+		}
+
+		if len(apiObject.TransitGatewayAttachments) > 0 && apiObject.TransitGatewayAttachments[0] != nil {
+			tfMap["blackhole"] = false
+			tfMap["transit_gateway_attachment_id"] = aws.StringValue(apiObject.TransitGatewayAttachments[0].TransitGatewayAttachmentId)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// Transit gateway route tables have no description, so it is stored in a reserved tag instead.
+// The key is namespaced so that it does not clash with a Description tag set in tags or default_tags.
+const transitGatewayRouteTableDescriptionTagKey = "terraform-provider-aws:description"
+
+// transitGatewayRouteTableDescriptionFromTags returns the description stored in the reserved tag
+// and the remaining tags, so that the reserved tag is not shown in tags or tags_all. Other tags are returned unchanged.
+func transitGatewayRouteTableDescriptionFromTags(tags tftags.KeyValueTags) (string, tftags.KeyValueTags) {
+	description := aws.StringValue(tags.KeyValue(transitGatewayRouteTableDescriptionTagKey))
+
+	return description, tags.Ignore(tftags.New([]string{transitGatewayRouteTableDescriptionTagKey}))
+}
+
+// defaultTagsConflictingKeys returns the sorted keys of the specified resource tags that are also
+// provider default tags but with a different value.
+func defaultTagsConflictingKeys(defaultTagsConfig *tftags.DefaultConfig, tags tftags.KeyValueTags) []string {
+	if defaultTagsConfig == nil {
+		return nil
+	}
+
+	var keys []string
+
+	for k, v := range tags.Map() {
+		if dv := defaultTagsConfig.Tags.KeyValue(k); dv != nil && aws.StringValue(dv) != v {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// transitGatewayRouteTableARN returns the ARN of a transit gateway route table.
+// The partition is taken from the provider configuration so that the ARN is correct in the GovCloud and China partitions.
+func transitGatewayRouteTableARN(partition, region, accountID, id string) string {
+	return arn.ARN{
+		Partition: partition,
+		Service:   ec2.ServiceName,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  fmt.Sprintf("transit-gateway-route-table/%s", id),
+	}.String()
+}
+
+// transitGatewayDefaultRouteTable reports whether the specified route table is the transit gateway's
+// default association and default propagation route table, according to the transit gateway's options.
+func transitGatewayDefaultRouteTable(transitGateway *ec2.TransitGateway, routeTableID string) (association, propagation bool) {
+	if transitGateway == nil || transitGateway.Options == nil {
+		return false, false
+	}
+
+	association = aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId) == routeTableID
+	propagation = aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId) == routeTableID
+
+	return association, propagation
+}
@@ -64,6 +64,10 @@ func resourceTransitGatewayRouteTableAssociationCreate(ctx context.Context, d *s
 		TransitGatewayRouteTableId: aws.String(transitGatewayRouteTableID),
 	}
 
+	if _, err := WaitTransitGatewayRouteTableAvailable(ctx, conn, transitGatewayRouteTableID, TransitGatewayRouteTableAvailableTimeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Route Table (%s) to become available: %s", transitGatewayRouteTableID, err)
+	}
+
 	_, err := conn.AssociateTransitGatewayRouteTableWithContext(ctx, input)
 
 	if err != nil {
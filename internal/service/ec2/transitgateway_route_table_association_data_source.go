@@ -0,0 +1,91 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func DataSourceTransitGatewayRouteTableAssociation() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTransitGatewayRouteTableAssociationRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_attachment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"transit_gateway_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayRouteTableAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn()
+
+	transitGatewayAttachmentID := d.Get("transit_gateway_attachment_id").(string)
+	transitGatewayRouteTableID, transitGatewayRouteTableAssociation, err := findTransitGatewayRouteTableAssociationByAttachmentID(ctx, conn, transitGatewayAttachmentID)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Transit Gateway Route Table Association", err))
+	}
+
+	d.SetId(TransitGatewayRouteTableAssociationCreateResourceID(transitGatewayRouteTableID, transitGatewayAttachmentID))
+	d.Set("resource_id", transitGatewayRouteTableAssociation.ResourceId)
+	d.Set("resource_type", transitGatewayRouteTableAssociation.ResourceType)
+	d.Set("transit_gateway_attachment_id", transitGatewayRouteTableAssociation.TransitGatewayAttachmentId)
+	d.Set("transit_gateway_route_table_id", transitGatewayRouteTableID)
+
+	return diags
+}
+
+// findTransitGatewayRouteTableAssociationByAttachmentID returns the ID of the route table that the specified attachment
+// is associated with and the association. An attachment is associated with at most one route table, which is read from
+// the attachment, as route table associations can only be listed per route table.
+func findTransitGatewayRouteTableAssociationByAttachmentID(ctx context.Context, conn *ec2.EC2, transitGatewayAttachmentID string) (string, *ec2.TransitGatewayRouteTableAssociation, error) {
+	transitGatewayAttachment, err := FindTransitGatewayAttachmentByID(ctx, conn, transitGatewayAttachmentID)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if transitGatewayAttachment.Association == nil || aws.StringValue(transitGatewayAttachment.Association.TransitGatewayRouteTableId) == "" {
+		return "", nil, &resource.NotFoundError{
+			Message: fmt.Sprintf("EC2 Transit Gateway Attachment (%s) is not associated with a route table", transitGatewayAttachmentID),
+		}
+	}
+
+	transitGatewayRouteTableID := aws.StringValue(transitGatewayAttachment.Association.TransitGatewayRouteTableId)
+	transitGatewayRouteTableAssociation, err := FindTransitGatewayRouteTableAssociationByTwoPartKey(ctx, conn, transitGatewayRouteTableID, transitGatewayAttachmentID)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return transitGatewayRouteTableID, transitGatewayRouteTableAssociation, nil
+}
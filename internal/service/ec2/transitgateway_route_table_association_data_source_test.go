@@ -0,0 +1,134 @@
+package ec2_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestFindTransitGatewayRouteTableAssociationByAttachmentID(t *testing.T) {
+	t.Parallel()
+
+	const (
+		attachmentID = "tgw-attach-12345678"
+		routeTableID = "tgw-rtb-12345678"
+	)
+
+	testCases := map[string]struct {
+		association    *ec2.TransitGatewayAttachmentAssociation
+		expectNotFound bool
+	}{
+		"associated": {
+			association: &ec2.TransitGatewayAttachmentAssociation{
+				State:                      aws.String(ec2.TransitGatewayAssociationStateAssociated),
+				TransitGatewayRouteTableId: aws.String(routeTableID),
+			},
+		},
+		"not associated": {
+			expectNotFound: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var requestedRouteTableID string
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				switch output := r.Data.(type) {
+				case *ec2.DescribeTransitGatewayAttachmentsOutput:
+					output.TransitGatewayAttachments = []*ec2.TransitGatewayAttachment{{
+						Association:                testCase.association,
+						TransitGatewayAttachmentId: aws.String(attachmentID),
+					}}
+				case *ec2.GetTransitGatewayRouteTableAssociationsOutput:
+					requestedRouteTableID = aws.StringValue(r.Params.(*ec2.GetTransitGatewayRouteTableAssociationsInput).TransitGatewayRouteTableId)
+					output.Associations = []*ec2.TransitGatewayRouteTableAssociation{{
+						ResourceId:                 aws.String("vpc-12345678"),
+						ResourceType:               aws.String(ec2.TransitGatewayAttachmentResourceTypeVpc),
+						State:                      aws.String(ec2.TransitGatewayAssociationStateAssociated),
+						TransitGatewayAttachmentId: aws.String(attachmentID),
+					}}
+				}
+			})
+
+			gotRouteTableID, got, err := tfec2.FindTransitGatewayRouteTableAssociationByAttachmentID(context.Background(), conn, attachmentID)
+
+			if testCase.expectNotFound {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected NotFound error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if gotRouteTableID != routeTableID {
+				t.Errorf("got route table ID %q, expected %q", gotRouteTableID, routeTableID)
+			}
+
+			if requestedRouteTableID != routeTableID {
+				t.Errorf("got associations of route table %q, expected %q", requestedRouteTableID, routeTableID)
+			}
+
+			if got, expected := aws.StringValue(got.ResourceId), "vpc-12345678"; got != expected {
+				t.Errorf("got resource ID %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func testAccTransitGatewayRouteTableAssociationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_transit_gateway_route_table_association.test"
+	resourceName := "aws_ec2_transit_gateway_route_table_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableAssociationDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", dataSourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_id", dataSourceName, "resource_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_type", dataSourceName, "resource_type"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_attachment_id", dataSourceName, "transit_gateway_attachment_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", dataSourceName, "transit_gateway_route_table_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTableAssociationDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableAssociationConfig_basic(rName), `
+data "aws_ec2_transit_gateway_route_table_association" "test" {
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_route_table_association.test.transit_gateway_attachment_id
+}
+`)
+}
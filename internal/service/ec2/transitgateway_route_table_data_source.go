@@ -2,11 +2,10 @@ package ec2
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,6 +42,18 @@ func DataSourceTransitGatewayRouteTable() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"is_default_association": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"is_default_propagation": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"state_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"transit_gateway_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -79,21 +90,72 @@ func dataSourceTransitGatewayRouteTableRead(ctx context.Context, d *schema.Resou
 	}
 
 	d.SetId(aws.StringValue(transitGatewayRouteTable.TransitGatewayRouteTableId))
-	arn := arn.ARN{
-		Partition: meta.(*conns.AWSClient).Partition,
-		Service:   ec2.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
-		AccountID: meta.(*conns.AWSClient).AccountID,
-		Resource:  fmt.Sprintf("transit-gateway-route-table/%s", d.Id()),
-	}.String()
-	d.Set("arn", arn)
+	d.Set("arn", transitGatewayRouteTableARN(meta.(*conns.AWSClient).Partition, meta.(*conns.AWSClient).Region, meta.(*conns.AWSClient).AccountID, d.Id()))
 	d.Set("default_association_route_table", transitGatewayRouteTable.DefaultAssociationRouteTable)
 	d.Set("default_propagation_route_table", transitGatewayRouteTable.DefaultPropagationRouteTable)
+
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, aws.StringValue(transitGatewayRouteTable.TransitGatewayId))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", aws.StringValue(transitGatewayRouteTable.TransitGatewayId), err)
+	}
+
+	isDefaultAssociation, isDefaultPropagation := transitGatewayDefaultRouteTable(transitGateway, d.Id())
+	d.Set("is_default_association", isDefaultAssociation)
+	d.Set("is_default_propagation", isDefaultPropagation)
 	d.Set("transit_gateway_id", transitGatewayRouteTable.TransitGatewayId)
 
-	if err := d.Set("tags", KeyValueTags(transitGatewayRouteTable.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+	tags := KeyValueTags(transitGatewayRouteTable.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()
+
+	if err := d.Set("tags", tags); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
 	}
 
+	stateJSON, err := transitGatewayRouteTableStateJSON(d.Get("arn").(string), transitGatewayRouteTable, isDefaultAssociation, isDefaultPropagation, tags)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting state_json: %s", err)
+	}
+
+	d.Set("state_json", stateJSON)
+
 	return diags
 }
+
+// transitGatewayRouteTableState is the document exposed as a route table's state_json.
+// Field order is fixed and map keys are sorted by encoding/json, so the output is stable.
+type transitGatewayRouteTableState struct {
+	ID                           string            `json:"id"`
+	ARN                          string            `json:"arn"`
+	TransitGatewayID             string            `json:"transit_gateway_id"`
+	State                        string            `json:"state"`
+	DefaultAssociationRouteTable bool              `json:"default_association_route_table"`
+	DefaultPropagationRouteTable bool              `json:"default_propagation_route_table"`
+	IsDefaultAssociation         bool              `json:"is_default_association"`
+	IsDefaultPropagation         bool              `json:"is_default_propagation"`
+	Tags                         map[string]string `json:"tags"`
+}
+
+func transitGatewayRouteTableStateJSON(arn string, apiObject *ec2.TransitGatewayRouteTable, isDefaultAssociation, isDefaultPropagation bool, tags map[string]string) (string, error) {
+	if tags == nil {
+		tags = map[string]string{}
+	}
+
+	b, err := json.Marshal(transitGatewayRouteTableState{
+		ID:                           aws.StringValue(apiObject.TransitGatewayRouteTableId),
+		ARN:                          arn,
+		TransitGatewayID:             aws.StringValue(apiObject.TransitGatewayId),
+		State:                        aws.StringValue(apiObject.State),
+		DefaultAssociationRouteTable: aws.BoolValue(apiObject.DefaultAssociationRouteTable),
+		DefaultPropagationRouteTable: aws.BoolValue(apiObject.DefaultPropagationRouteTable),
+		IsDefaultAssociation:         isDefaultAssociation,
+		IsDefaultPropagation:         isDefaultPropagation,
+		Tags:                         tags,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
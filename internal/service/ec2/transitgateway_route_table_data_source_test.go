@@ -1,15 +1,71 @@
 package ec2_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
+func TestTransitGatewayRouteTableStateJSON(t *testing.T) {
+	t.Parallel()
+
+	apiObject := &ec2.TransitGatewayRouteTable{
+		DefaultAssociationRouteTable: aws.Bool(true),
+		DefaultPropagationRouteTable: aws.Bool(false),
+		State:                        aws.String(ec2.TransitGatewayRouteTableStateAvailable),
+		TransitGatewayId:             aws.String("tgw-12345678"),
+		TransitGatewayRouteTableId:   aws.String("tgw-rtb-12345678"),
+	}
+	arn := "arn:aws:ec2:us-west-2:123456789012:transit-gateway-route-table/tgw-rtb-12345678" //lintignore:AWSAT003,AWSAT005
+
+	got, err := tfec2.TransitGatewayRouteTableStateJSON(arn, apiObject, true, false, map[string]string{"Name": "test", "Environment": "prod"})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var document map[string]interface{}
+
+	if err := json.Unmarshal([]byte(got), &document); err != nil {
+		t.Fatalf("unmarshaling %q: %s", got, err)
+	}
+
+	for _, key := range []string{"id", "arn", "transit_gateway_id", "state", "default_association_route_table", "default_propagation_route_table", "is_default_association", "is_default_propagation", "tags"} {
+		if _, ok := document[key]; !ok {
+			t.Errorf("key %q missing from %s", key, got)
+		}
+	}
+
+	expected := `{"id":"tgw-rtb-12345678","arn":"` + arn + `","transit_gateway_id":"tgw-12345678","state":"available","default_association_route_table":true,"default_propagation_route_table":false,"is_default_association":true,"is_default_propagation":false,"tags":{"Environment":"prod","Name":"test"}}`
+
+	if got != expected {
+		t.Errorf("got %s, expected %s", got, expected)
+	}
+
+	got, err = tfec2.TransitGatewayRouteTableStateJSON(arn, apiObject, true, false, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	document = nil
+
+	if err := json.Unmarshal([]byte(got), &document); err != nil {
+		t.Fatalf("unmarshaling %q: %s", got, err)
+	}
+
+	if _, ok := document["tags"].(map[string]interface{}); !ok {
+		t.Errorf("expected empty tags object in %s", got)
+	}
+}
+
 func testAccTransitGatewayRouteTableDataSource_Filter(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_ec2_transit_gateway_route_table.test"
@@ -28,6 +84,9 @@ func testAccTransitGatewayRouteTableDataSource_Filter(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "arn"),
 					resource.TestCheckResourceAttrPair(resourceName, "default_association_route_table", dataSourceName, "default_association_route_table"),
 					resource.TestCheckResourceAttrPair(resourceName, "default_propagation_route_table", dataSourceName, "default_propagation_route_table"),
+					resource.TestCheckResourceAttrPair(resourceName, "is_default_association", dataSourceName, "is_default_association"),
+					resource.TestCheckResourceAttrPair(resourceName, "is_default_propagation", dataSourceName, "is_default_propagation"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "state_json"),
 					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
 					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", dataSourceName, "transit_gateway_id"),
 				),
@@ -36,6 +95,32 @@ func testAccTransitGatewayRouteTableDataSource_Filter(t *testing.T) {
 	})
 }
 
+func testAccTransitGatewayRouteTableDataSource_defaultRouteTable(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_transit_gateway_route_table.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableDataSourceConfig_defaultRouteTable(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", transitGatewayResourceName, "association_default_route_table_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_association_route_table", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "default_propagation_route_table", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_default_association", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_default_propagation", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGatewayRouteTableDataSource_ID(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_ec2_transit_gateway_route_table.test"
@@ -54,6 +139,8 @@ func testAccTransitGatewayRouteTableDataSource_ID(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "arn", dataSourceName, "arn"),
 					resource.TestCheckResourceAttrPair(resourceName, "default_association_route_table", dataSourceName, "default_association_route_table"),
 					resource.TestCheckResourceAttrPair(resourceName, "default_propagation_route_table", dataSourceName, "default_propagation_route_table"),
+					resource.TestCheckResourceAttrPair(resourceName, "is_default_association", dataSourceName, "is_default_association"),
+					resource.TestCheckResourceAttrPair(resourceName, "is_default_propagation", dataSourceName, "is_default_propagation"),
 					resource.TestCheckResourceAttrPair(resourceName, "tags.%", dataSourceName, "tags.%"),
 					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", dataSourceName, "transit_gateway_id"),
 				),
@@ -87,6 +174,20 @@ data "aws_ec2_transit_gateway_route_table" "test" {
 `, rName)
 }
 
+func testAccTransitGatewayRouteTableDataSourceConfig_defaultRouteTable(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_transit_gateway_route_table" "test" {
+  id = aws_ec2_transit_gateway.test.association_default_route_table_id
+}
+`, rName)
+}
+
 func testAccTransitGatewayRouteTableDataSourceConfig_id(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -4,25 +4,1025 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"regexp"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/go-cty/cty"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestTransitGatewayDefaultRouteTable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		transitGateway      *ec2.TransitGateway
+		routeTableID        string
+		expectedAssociation bool
+		expectedPropagation bool
+	}{
+		"nil transit gateway": {
+			routeTableID: "tgw-rtb-11111111111111111",
+		},
+		"no options": {
+			transitGateway: &ec2.TransitGateway{},
+			routeTableID:   "tgw-rtb-11111111111111111",
+		},
+		"default association and propagation": {
+			transitGateway: &ec2.TransitGateway{
+				Options: &ec2.TransitGatewayOptions{
+					AssociationDefaultRouteTableId: aws.String("tgw-rtb-11111111111111111"),
+					PropagationDefaultRouteTableId: aws.String("tgw-rtb-11111111111111111"),
+				},
+			},
+			routeTableID:        "tgw-rtb-11111111111111111",
+			expectedAssociation: true,
+			expectedPropagation: true,
+		},
+		"default propagation only": {
+			transitGateway: &ec2.TransitGateway{
+				Options: &ec2.TransitGatewayOptions{
+					AssociationDefaultRouteTableId: aws.String("tgw-rtb-22222222222222222"),
+					PropagationDefaultRouteTableId: aws.String("tgw-rtb-11111111111111111"),
+				},
+			},
+			routeTableID:        "tgw-rtb-11111111111111111",
+			expectedPropagation: true,
+		},
+		"defaults disabled": {
+			transitGateway: &ec2.TransitGateway{
+				Options: &ec2.TransitGatewayOptions{
+					DefaultRouteTableAssociation: aws.String(ec2.DefaultRouteTableAssociationValueDisable),
+					DefaultRouteTablePropagation: aws.String(ec2.DefaultRouteTablePropagationValueDisable),
+				},
+			},
+			routeTableID: "tgw-rtb-11111111111111111",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			association, propagation := tfec2.TransitGatewayDefaultRouteTable(testCase.transitGateway, testCase.routeTableID)
+
+			if association != testCase.expectedAssociation {
+				t.Errorf("got association %t, expected %t", association, testCase.expectedAssociation)
+			}
+
+			if propagation != testCase.expectedPropagation {
+				t.Errorf("got propagation %t, expected %t", propagation, testCase.expectedPropagation)
+			}
+		})
+	}
+}
+
+func TestWaitTransitGatewayRouteTableAvailable(t *testing.T) {
+	t.Parallel()
+
+	const id = "tgw-rtb-12345678"
+
+	testCases := map[string]struct {
+		states      []string
+		expectError bool
+	}{
+		"pending to available": {
+			states: []string{ec2.TransitGatewayRouteTableStatePending, ec2.TransitGatewayRouteTableStatePending, ec2.TransitGatewayRouteTableStateAvailable},
+		},
+		"deleting": {
+			states:      []string{ec2.TransitGatewayRouteTableStateDeleting},
+			expectError: true,
+		},
+		"deleted": {
+			states:      []string{ec2.TransitGatewayRouteTableStatePending, ec2.TransitGatewayRouteTableStateDeleted},
+			expectError: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests int
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				state := testCase.states[len(testCase.states)-1]
+				if requests < len(testCase.states) {
+					state = testCase.states[requests]
+				}
+				requests++
+
+				r.Data.(*ec2.DescribeTransitGatewayRouteTablesOutput).TransitGatewayRouteTables = []*ec2.TransitGatewayRouteTable{{
+					State:                      aws.String(state),
+					TransitGatewayRouteTableId: aws.String(id),
+				}}
+			})
+
+			output, err := tfec2.WaitTransitGatewayRouteTableAvailable(context.Background(), conn, id, time.Minute)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+
+				// Failure states are reported as soon as they are seen.
+				if got, expected := requests, len(testCase.states); got != expected {
+					t.Errorf("got %d requests, expected %d", got, expected)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := aws.StringValue(output.State), ec2.TransitGatewayRouteTableStateAvailable; got != expected {
+				t.Errorf("got state %s, expected %s", got, expected)
+			}
+
+			if got, expected := requests, len(testCase.states); got != expected {
+				t.Errorf("got %d requests, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+func TestDefaultTagsConflictingKeys(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		defaultTagsConfig *tftags.DefaultConfig
+		tags              tftags.KeyValueTags
+		expected          []string
+	}{
+		"no default tags": {
+			tags: tftags.New(map[string]interface{}{"Name": "test"}),
+		},
+		"no overlap": {
+			defaultTagsConfig: &tftags.DefaultConfig{Tags: tftags.New(map[string]interface{}{"Environment": "prod"})},
+			tags:              tftags.New(map[string]interface{}{"Name": "test"}),
+		},
+		"same value": {
+			defaultTagsConfig: &tftags.DefaultConfig{Tags: tftags.New(map[string]interface{}{"Environment": "prod"})},
+			tags:              tftags.New(map[string]interface{}{"Environment": "prod"}),
+		},
+		"conflicting value": {
+			defaultTagsConfig: &tftags.DefaultConfig{Tags: tftags.New(map[string]interface{}{"Environment": "prod", "Team": "network"})},
+			tags:              tftags.New(map[string]interface{}{"Environment": "test", "Name": "test", "Team": "platform"}),
+			expected:          []string{"Environment", "Team"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfec2.DefaultTagsConflictingKeys(testCase.defaultTagsConfig, testCase.tags)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRouteTableAssociationAndPropagationCount(t *testing.T) {
+	t.Parallel()
+
+	const id = "tgw-rtb-12345678"
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := ec2.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch output := r.Data.(type) {
+		case *ec2.GetTransitGatewayRouteTableAssociationsOutput:
+			if r.Params.(*ec2.GetTransitGatewayRouteTableAssociationsInput).NextToken == nil {
+				output.Associations = []*ec2.TransitGatewayRouteTableAssociation{
+					{State: aws.String(ec2.TransitGatewayAssociationStateAssociated), TransitGatewayAttachmentId: aws.String("tgw-attach-11111111")},
+					{State: aws.String(ec2.TransitGatewayAssociationStateDisassociating), TransitGatewayAttachmentId: aws.String("tgw-attach-22222222")},
+				}
+				output.NextToken = aws.String("page2")
+			} else {
+				output.Associations = []*ec2.TransitGatewayRouteTableAssociation{
+					{State: aws.String(ec2.TransitGatewayAssociationStateAssociated), TransitGatewayAttachmentId: aws.String("tgw-attach-33333333")},
+				}
+			}
+		case *ec2.GetTransitGatewayRouteTablePropagationsOutput:
+			output.TransitGatewayRouteTablePropagations = []*ec2.TransitGatewayRouteTablePropagation{
+				{State: aws.String(ec2.TransitGatewayPropagationStateEnabled), TransitGatewayAttachmentId: aws.String("tgw-attach-11111111")},
+			}
+		}
+	})
+
+	associationCount, err := tfec2.TransitGatewayRouteTableAssociationCount(context.Background(), conn, id)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := 2; associationCount != expected {
+		t.Errorf("got association count %d, expected %d", associationCount, expected)
+	}
+
+	propagationCount, err := tfec2.TransitGatewayRouteTablePropagationCount(context.Background(), conn, id)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if expected := 1; propagationCount != expected {
+		t.Errorf("got propagation count %d, expected %d", propagationCount, expected)
+	}
+}
+
+func TestTransitGatewayRouteTableReadDetails(t *testing.T) {
+	t.Parallel()
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := ec2.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		switch output := r.Data.(type) {
+		case *ec2.GetTransitGatewayRouteTableAssociationsOutput:
+			output.Associations = []*ec2.TransitGatewayRouteTableAssociation{
+				{State: aws.String(ec2.TransitGatewayAssociationStateAssociated), TransitGatewayAttachmentId: aws.String("tgw-attach-11111111")},
+			}
+		case *ec2.GetTransitGatewayRouteTablePropagationsOutput:
+			r.Error = awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil)
+		}
+	})
+
+	transitGateway := &ec2.TransitGateway{
+		Options: &ec2.TransitGatewayOptions{
+			AssociationDefaultRouteTableId: aws.String("tgw-rtb-12345678"),
+		},
+		OwnerId:          aws.String("123456789012"),
+		State:            aws.String(ec2.TransitGatewayStateAvailable),
+		TransitGatewayId: aws.String("tgw-12345678"),
+	}
+
+	d := tfec2.ResourceTransitGatewayRouteTable().TestResourceData()
+	d.SetId("tgw-rtb-12345678")
+
+	diags := tfec2.TransitGatewayRouteTableReadDetails(context.Background(), conn, d, transitGateway)
+
+	// A detail that cannot be read is a warning, not an error.
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, expected := len(diags), 1; got != expected {
+		t.Errorf("got %d warnings, expected %d", got, expected)
+	}
+
+	if got, expected := d.Get("association_count").(int), 1; got != expected {
+		t.Errorf("got association count %d, expected %d", got, expected)
+	}
+
+	if got, expected := d.Get("is_default_association").(bool), true; got != expected {
+		t.Errorf("got is_default_association %t, expected %t", got, expected)
+	}
+}
+
+func TestTransitGatewayRouteTableRemoveAttachments(t *testing.T) {
+	t.Parallel()
+
+	const id = "tgw-rtb-12345678"
+
+	testCases := map[string]struct {
+		failAttachmentID string
+		expectError      bool
+	}{
+		"all removed": {},
+		"one fails": {
+			failAttachmentID: "tgw-attach-2",
+			expectError:      true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var mu sync.Mutex
+			associations := map[string]string{
+				"tgw-attach-1": ec2.TransitGatewayAssociationStateAssociated,
+				"tgw-attach-2": ec2.TransitGatewayAssociationStateAssociated,
+				"tgw-attach-3": ec2.TransitGatewayAssociationStateAssociated,
+				"tgw-attach-4": ec2.TransitGatewayAssociationStateDisassociated,
+			}
+			propagations := map[string]string{
+				"tgw-attach-1": ec2.TransitGatewayPropagationStateEnabled,
+				"tgw-attach-5": ec2.TransitGatewayPropagationStateEnabled,
+			}
+			var disassociated, disabled []string
+
+			// The attachment ID filter that the waiters use, if any.
+			attachmentIDFilter := func(filters []*ec2.Filter) string {
+				for _, v := range filters {
+					if aws.StringValue(v.Name) == "transit-gateway-attachment-id" && len(v.Values) > 0 {
+						return aws.StringValue(v.Values[0])
+					}
+				}
+
+				return ""
+			}
+
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+
+				switch input := r.Params.(type) {
+				case *ec2.GetTransitGatewayRouteTableAssociationsInput:
+					filter := attachmentIDFilter(input.Filters)
+					output := r.Data.(*ec2.GetTransitGatewayRouteTableAssociationsOutput)
+
+					for attachmentID, state := range associations {
+						if filter == "" || filter == attachmentID {
+							output.Associations = append(output.Associations, &ec2.TransitGatewayRouteTableAssociation{
+								State:                      aws.String(state),
+								TransitGatewayAttachmentId: aws.String(attachmentID),
+							})
+						}
+					}
+				case *ec2.GetTransitGatewayRouteTablePropagationsInput:
+					filter := attachmentIDFilter(input.Filters)
+					output := r.Data.(*ec2.GetTransitGatewayRouteTablePropagationsOutput)
+
+					for attachmentID, state := range propagations {
+						if filter == "" || filter == attachmentID {
+							output.TransitGatewayRouteTablePropagations = append(output.TransitGatewayRouteTablePropagations, &ec2.TransitGatewayRouteTablePropagation{
+								State:                      aws.String(state),
+								TransitGatewayAttachmentId: aws.String(attachmentID),
+							})
+						}
+					}
+				case *ec2.DisassociateTransitGatewayRouteTableInput:
+					attachmentID := aws.StringValue(input.TransitGatewayAttachmentId)
+
+					if attachmentID == testCase.failAttachmentID {
+						r.Error = awserr.New("IncorrectState", "failed", nil)
+						return
+					}
+
+					associations[attachmentID] = ec2.TransitGatewayAssociationStateDisassociated
+					disassociated = append(disassociated, attachmentID)
+				case *ec2.DisableTransitGatewayRouteTablePropagationInput:
+					attachmentID := aws.StringValue(input.TransitGatewayAttachmentId)
+					propagations[attachmentID] = ec2.TransitGatewayPropagationStateDisabled
+					disabled = append(disabled, attachmentID)
+				}
+			})
+
+			err := tfec2.TransitGatewayRouteTableRemoveAttachments(context.Background(), conn, id)
+
+			if testCase.expectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				if !strings.Contains(err.Error(), testCase.failAttachmentID) {
+					t.Errorf("expected error to mention %s, got %s", testCase.failAttachmentID, err)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expectedDisassociated := []string{"tgw-attach-1", "tgw-attach-2", "tgw-attach-3"}
+			if testCase.failAttachmentID != "" {
+				expectedDisassociated = []string{"tgw-attach-1", "tgw-attach-3"}
+			}
+
+			sort.Strings(disassociated)
+			if !reflect.DeepEqual(disassociated, expectedDisassociated) {
+				t.Errorf("got disassociated attachments %v, expected %v", disassociated, expectedDisassociated)
+			}
+
+			sort.Strings(disabled)
+			if expected := []string{"tgw-attach-1", "tgw-attach-5"}; !reflect.DeepEqual(disabled, expected) {
+				t.Errorf("got disabled propagations %v, expected %v", disabled, expected)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRouteTableHasBlackholeRoutes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		routes   []*ec2.TransitGatewayRoute
+		expected bool
+	}{
+		"no routes": {},
+		"blackhole route": {
+			routes: []*ec2.TransitGatewayRoute{
+				{DestinationCidrBlock: aws.String("10.1.0.0/16"), State: aws.String(ec2.TransitGatewayRouteStateBlackhole)},
+			},
+			expected: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var input *ec2.SearchTransitGatewayRoutesInput
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				input = r.Params.(*ec2.SearchTransitGatewayRoutesInput)
+				r.Data.(*ec2.SearchTransitGatewayRoutesOutput).Routes = testCase.routes
+			})
+
+			got, err := tfec2.TransitGatewayRouteTableHasBlackholeRoutes(context.Background(), conn, "tgw-rtb-12345678")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+
+			expectedFilters := []*ec2.Filter{{Name: aws.String("state"), Values: aws.StringSlice([]string{ec2.TransitGatewayRouteStateBlackhole})}}
+			if !reflect.DeepEqual(input.Filters, expectedFilters) {
+				t.Errorf("got filters %v, expected %v", input.Filters, expectedFilters)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRouteTableUnexpectedUpdateKeys(t *testing.T) {
+	t.Parallel()
+
+	schemaMap := tfec2.ResourceTransitGatewayRouteTable().Schema
+
+	// A route table schema with an argument that the update function does not apply.
+	schemaMapWithUnhandledArgument := make(map[string]*schema.Schema, len(schemaMap)+1)
+	for k, v := range schemaMap {
+		schemaMapWithUnhandledArgument[k] = v
+	}
+	schemaMapWithUnhandledArgument["example"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	// Every argument of the resource, as if all of them had changed.
+	var allKeys []string
+	for k := range schemaMap {
+		allKeys = append(allKeys, k)
+	}
+
+	testCases := map[string]struct {
+		schemaMap   map[string]*schema.Schema
+		changedKeys []string
+		expected    []string
+	}{
+		"no changes": {
+			schemaMap: schemaMap,
+		},
+		"all arguments": {
+			schemaMap:   schemaMap,
+			changedKeys: allKeys,
+		},
+		"tags": {
+			schemaMap:   schemaMap,
+			changedKeys: []string{"tags.%", "tags.key1", "tags_all.%", "tags_all.key1"},
+		},
+		"updatable arguments": {
+			schemaMap:   schemaMap,
+			changedKeys: []string{"description", "set_as_default_association"},
+		},
+		"force new argument": {
+			schemaMap:   schemaMap,
+			changedKeys: []string{"transit_gateway_id"},
+		},
+		"computed attributes": {
+			schemaMap:   schemaMap,
+			changedKeys: []string{"default_association_route_table", "is_default_association", "previous_default_association_route_table_id"},
+		},
+		"unhandled argument": {
+			schemaMap:   schemaMapWithUnhandledArgument,
+			changedKeys: []string{"tags.%", "example", "description"},
+			expected:    []string{"example"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfec2.TransitGatewayRouteTableUnexpectedUpdateKeys(testCase.schemaMap, testCase.changedKeys)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestResourceTransitGatewayRouteTableCustomizeDiff_update(t *testing.T) {
+	t.Parallel()
+
+	r := tfec2.ResourceTransitGatewayRouteTable()
+	d := r.TestResourceData()
+	d.SetId("tgw-rtb-12345678")
+	d.Set("description", "old")
+	d.Set("transit_gateway_id", "tgw-12345678")
+
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"description":        "new",
+		"transit_gateway_id": "tgw-12345678",
+		"tags": map[string]interface{}{
+			"key1": "value1",
+		},
+	}), &conns.AWSClient{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff.RequiresNew() {
+		t.Error("expected in-place update")
+	}
+}
+
+func TestTransitGatewayRouteTableRouteHash(t *testing.T) {
+	t.Parallel()
+
+	route := func(destination, attachmentID string, blackhole bool) map[string]interface{} {
+		return map[string]interface{}{
+			"blackhole":                     blackhole,
+			"destination_cidr_block":        destination,
+			"transit_gateway_attachment_id": attachmentID,
+		}
+	}
+
+	if got, expected := tfec2.TransitGatewayRouteTableRouteHash(route("2001:db8::/56", "tgw-attach-1", false)), tfec2.TransitGatewayRouteTableRouteHash(route("2001:0db8::/56", "tgw-attach-1", false)); got != expected {
+		t.Errorf("equivalent IPv6 destinations hashed differently: %d, %d", got, expected)
+	}
+
+	if got, other := tfec2.TransitGatewayRouteTableRouteHash(route("10.1.0.0/16", "tgw-attach-1", false)), tfec2.TransitGatewayRouteTableRouteHash(route("10.1.0.0/16", "", true)); got == other {
+		t.Errorf("routes with different targets hashed the same: %d", got)
+	}
+
+	if got, other := tfec2.TransitGatewayRouteTableRouteHash(route("10.0.0.0/8", "tgw-attach-1", false)), tfec2.TransitGatewayRouteTableRouteHash(route("::ffff:10.0.0.0/104", "tgw-attach-1", false)); got == other {
+		t.Errorf("IPv4 and IPv4-mapped IPv6 routes hashed the same: %d", got)
+	}
+}
+
+func TestTransitGatewayRouteTableValidateRouteTargets(t *testing.T) {
+	t.Parallel()
+
+	route := func(attachmentID, blackhole cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"blackhole":                     blackhole,
+			"destination_cidr_block":        cty.StringVal("10.1.0.0/16"),
+			"transit_gateway_attachment_id": attachmentID,
+		})
+	}
+
+	testCases := map[string]struct {
+		routes      cty.Value
+		expectError bool
+	}{
+		"no routes": {
+			routes: cty.NullVal(cty.Set(route(cty.NullVal(cty.String), cty.NullVal(cty.Bool)).Type())),
+		},
+		"attachment": {
+			routes: cty.SetVal([]cty.Value{route(cty.StringVal("tgw-attach-1"), cty.NullVal(cty.Bool))}),
+		},
+		"blackhole": {
+			routes: cty.SetVal([]cty.Value{route(cty.NullVal(cty.String), cty.True)}),
+		},
+		"unknown attachment": {
+			routes: cty.SetVal([]cty.Value{route(cty.UnknownVal(cty.String), cty.False)}),
+		},
+		"neither": {
+			routes:      cty.SetVal([]cty.Value{route(cty.NullVal(cty.String), cty.NullVal(cty.Bool))}),
+			expectError: true,
+		},
+		"empty attachment": {
+			routes:      cty.SetVal([]cty.Value{route(cty.StringVal(""), cty.False)}),
+			expectError: true,
+		},
+		"both": {
+			routes:      cty.SetVal([]cty.Value{route(cty.StringVal("tgw-attach-1"), cty.True)}),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfec2.TransitGatewayRouteTableValidateRouteTargets(testCase.routes)
+
+			if got, expected := err != nil, testCase.expectError; got != expected {
+				t.Errorf("got error %v, expected error: %t", err, expected)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRouteTableRoutesDiff(t *testing.T) {
+	t.Parallel()
+
+	route := func(destination, attachmentID string, blackhole bool) interface{} {
+		return map[string]interface{}{
+			"blackhole":                     blackhole,
+			"destination_cidr_block":        destination,
+			"transit_gateway_attachment_id": attachmentID,
+		}
+	}
+
+	testCases := map[string]struct {
+		old             []interface{}
+		new             []interface{}
+		expectedAdd     []string
+		expectedReplace []string
+		expectedRemove  []string
+	}{
+		"create": {
+			new:         []interface{}{route("10.1.0.0/16", "tgw-attach-1", false)},
+			expectedAdd: []string{"10.1.0.0/16"},
+		},
+		"unchanged": {
+			old: []interface{}{route("10.1.0.0/16", "tgw-attach-1", false)},
+			new: []interface{}{route("10.1.0.0/16", "tgw-attach-1", false)},
+		},
+		"equivalent IPv6 destination": {
+			old: []interface{}{route("2001:0db8::/56", "tgw-attach-1", false)},
+			new: []interface{}{route("2001:db8::/56", "tgw-attach-1", false)},
+		},
+		"change target": {
+			old:             []interface{}{route("10.1.0.0/16", "tgw-attach-1", false)},
+			new:             []interface{}{route("10.1.0.0/16", "", true)},
+			expectedReplace: []string{"10.1.0.0/16"},
+		},
+		"IPv4 and IPv6 default routes": {
+			new: []interface{}{
+				route("0.0.0.0/0", "tgw-attach-1", false),
+				route("::/0", "tgw-attach-1", false),
+			},
+			expectedAdd: []string{"0.0.0.0/0", "::/0"},
+		},
+		"IPv4-mapped IPv6 destination": {
+			old: []interface{}{route("10.0.0.0/8", "tgw-attach-1", false)},
+			new: []interface{}{
+				route("10.0.0.0/8", "tgw-attach-1", false),
+				route("::ffff:10.0.0.0/104", "", true),
+			},
+			expectedAdd: []string{"::ffff:10.0.0.0/104"},
+		},
+		"change address family": {
+			old:            []interface{}{route("0.0.0.0/0", "tgw-attach-1", false)},
+			new:            []interface{}{route("::/0", "tgw-attach-1", false)},
+			expectedAdd:    []string{"::/0"},
+			expectedRemove: []string{"0.0.0.0/0"},
+		},
+		"add and remove": {
+			old: []interface{}{
+				route("10.1.0.0/16", "tgw-attach-1", false),
+				route("10.3.0.0/16", "", true),
+				route("10.2.0.0/16", "tgw-attach-1", false),
+			},
+			new: []interface{}{
+				route("10.1.0.0/16", "tgw-attach-1", false),
+				route("10.4.0.0/16", "tgw-attach-2", false),
+			},
+			expectedAdd:    []string{"10.4.0.0/16"},
+			expectedRemove: []string{"10.2.0.0/16", "10.3.0.0/16"},
+		},
+	}
+
+	destinations := func(tfList []map[string]interface{}) []string {
+		var destinations []string
+
+		for _, tfMap := range tfList {
+			destinations = append(destinations, tfMap["destination_cidr_block"].(string))
+		}
+
+		return destinations
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			add, replace, remove := tfec2.TransitGatewayRouteTableRoutesDiff(testCase.old, testCase.new)
+
+			if got := destinations(add); !reflect.DeepEqual(got, testCase.expectedAdd) {
+				t.Errorf("got routes to create %v, expected %v", got, testCase.expectedAdd)
+			}
+
+			if got := destinations(replace); !reflect.DeepEqual(got, testCase.expectedReplace) {
+				t.Errorf("got routes to replace %v, expected %v", got, testCase.expectedReplace)
+			}
+
+			if !reflect.DeepEqual(remove, testCase.expectedRemove) {
+				t.Errorf("got routes to delete %v, expected %v", remove, testCase.expectedRemove)
+			}
+		})
+	}
+}
+
+func TestFlattenTransitGatewayRouteTableRoutes(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []*ec2.TransitGatewayRoute{
+		{
+			DestinationCidrBlock: aws.String("10.0.0.0/8"),
+			TransitGatewayAttachments: []*ec2.TransitGatewayRouteAttachment{{
+				TransitGatewayAttachmentId: aws.String("tgw-attach-1"),
+			}},
+		},
+		{
+			DestinationCidrBlock: aws.String("2001:db8::/56"),
+		},
+	}
+	configured := []interface{}{
+		map[string]interface{}{
+			"blackhole":                     false,
+			"destination_cidr_block":        "10.0.0.0/8",
+			"transit_gateway_attachment_id": "tgw-attach-1",
+		},
+		map[string]interface{}{
+			"blackhole":                     true,
+			"destination_cidr_block":        "2001:0db8::/56",
+			"transit_gateway_attachment_id": "",
+		},
+	}
+
+	got := tfec2.FlattenTransitGatewayRouteTableRoutes(apiObjects, configured)
+
+	if !reflect.DeepEqual(got, configured) {
+		t.Errorf("got %v, expected %v", got, configured)
+	}
+}
+
+func TestTransitGatewayRouteTableDescriptionFromTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tags                tftags.KeyValueTags
+		expectedDescription string
+		expectedTags        map[string]string
+	}{
+		"no tags": {
+			tags:         tftags.New(nil),
+			expectedTags: map[string]string{},
+		},
+		"no description": {
+			tags:         tftags.New(map[string]string{"Name": "test"}),
+			expectedTags: map[string]string{"Name": "test"},
+		},
+		"description": {
+			tags:                tftags.New(map[string]string{"terraform-provider-aws:description": "test description", "Name": "test"}),
+			expectedDescription: "test description",
+			expectedTags:        map[string]string{"Name": "test"},
+		},
+		"Description tag": {
+			tags:                tftags.New(map[string]string{"terraform-provider-aws:description": "test description", "Description": "test tag"}),
+			expectedDescription: "test description",
+			expectedTags:        map[string]string{"Description": "test tag"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			description, tags := tfec2.TransitGatewayRouteTableDescriptionFromTags(testCase.tags)
+
+			if description != testCase.expectedDescription {
+				t.Errorf("got description %q, expected %q", description, testCase.expectedDescription)
+			}
+
+			if got := tags.Map(); !reflect.DeepEqual(got, testCase.expectedTags) {
+				t.Errorf("got tags %v, expected %v", got, testCase.expectedTags)
+			}
+		})
+	}
+}
+
+func TestTransitGatewayRouteTableARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		partition string
+		region    string
+		expected  string
+	}{
+		"aws": {
+			partition: endpoints.AwsPartitionID,
+			region:    endpoints.UsEast1RegionID,
+			expected:  "arn:aws:ec2:us-east-1:123456789012:transit-gateway-route-table/tgw-rtb-12345678",
+		},
+		"aws-us-gov": {
+			partition: endpoints.AwsUsGovPartitionID,
+			region:    endpoints.UsGovWest1RegionID,
+			expected:  "arn:aws-us-gov:ec2:us-gov-west-1:123456789012:transit-gateway-route-table/tgw-rtb-12345678",
+		},
+		"aws-cn": {
+			partition: endpoints.AwsCnPartitionID,
+			region:    endpoints.CnNorth1RegionID,
+			expected:  "arn:aws-cn:ec2:cn-north-1:123456789012:transit-gateway-route-table/tgw-rtb-12345678",
+		},
+		"no region": {
+			partition: endpoints.AwsPartitionID,
+			expected:  "arn:aws:ec2::123456789012:transit-gateway-route-table/tgw-rtb-12345678",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfec2.TransitGatewayRouteTableARN(testCase.partition, testCase.region, "123456789012", "tgw-rtb-12345678")
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+
+			parsed, err := arn.Parse(got)
+
+			if err != nil {
+				t.Fatalf("%s is not a valid ARN: %s", got, err)
+			}
+
+			// EC2 uses the same service name in every partition.
+			if parsed.Service != "ec2" {
+				t.Errorf("got service %q, expected %q", parsed.Service, "ec2")
+			}
+
+			if testCase.region != "" {
+				if partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), testCase.region); !ok || partition.ID() != parsed.Partition {
+					t.Errorf("got partition %q, expected partition of region %s", parsed.Partition, testCase.region)
+				}
+			}
+		})
+	}
+}
+
 func testAccTransitGatewayRouteTable_basic(t *testing.T) {
 	ctx := acctest.Context(t)
-	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`transit-gateway-route-table/tgw-rtb-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "default_association_route_table", "false"),
+					resource.TestCheckResourceAttr(resourceName, "default_propagation_route_table", "false"),
+					resource.TestCheckResourceAttr(resourceName, "fetch_details", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "available"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_owner_id", transitGatewayResourceName, "owner_id"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTransitGatewayRouteTable(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTable_disappears_TransitGateway(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGateway1 ec2.TransitGateway
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitGateway1),
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTransitGateway(), transitGatewayResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTable_Tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTable1, transitGatewayRouteTable2, transitGatewayRouteTable3 ec2.TransitGatewayRouteTable
 	resourceName := "aws_ec2_transit_gateway_route_table.test"
-	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
 	resource.Test(t, resource.TestCase{
@@ -32,14 +1032,11 @@ func testAccTransitGatewayRouteTable_basic(t *testing.T) {
 		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTransitGatewayRouteTableConfig_basic(rName),
+				Config: testAccTransitGatewayRouteTableConfig_tags1(rName, "key1", "value1"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
-					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ec2", regexp.MustCompile(`transit-gateway-route-table/tgw-rtb-.+`)),
-					resource.TestCheckResourceAttr(resourceName, "default_association_route_table", "false"),
-					resource.TestCheckResourceAttr(resourceName, "default_propagation_route_table", "false"),
-					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
 				),
 			},
 			{
@@ -47,11 +1044,91 @@ func testAccTransitGatewayRouteTable_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccTransitGatewayRouteTableConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable2),
+					testAccCheckTransitGatewayRouteTableNotRecreated(&transitGatewayRouteTable1, &transitGatewayRouteTable2),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTableConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable3),
+					testAccCheckTransitGatewayRouteTableNotRecreated(&transitGatewayRouteTable2, &transitGatewayRouteTable3),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
 		},
 	})
 }
 
-func testAccTransitGatewayRouteTable_disappears(t *testing.T) {
+func testAccTransitGatewayRouteTable_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTable1, transitGatewayRouteTable2, transitGatewayRouteTable3 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_description(rName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					testAccCheckTransitGatewayRouteTableDescriptionTag(&transitGatewayRouteTable1, "description1"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTransitGatewayRouteTableConfig_description(rName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable2),
+					testAccCheckTransitGatewayRouteTableNotRecreated(&transitGatewayRouteTable1, &transitGatewayRouteTable2),
+					testAccCheckTransitGatewayRouteTableDescriptionTag(&transitGatewayRouteTable2, "description2"),
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				Config: testAccTransitGatewayRouteTableConfig_description(rName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable3),
+					testAccCheckTransitGatewayRouteTableNotRecreated(&transitGatewayRouteTable2, &transitGatewayRouteTable3),
+					testAccCheckTransitGatewayRouteTableDescriptionTag(&transitGatewayRouteTable3, ""),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				// A Description tag is an ordinary tag.
+				Config: testAccTransitGatewayRouteTableConfig_tags1(rName, "Description", "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Description", "description1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTable_timeouts(t *testing.T) {
 	ctx := acctest.Context(t)
 	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
 	resourceName := "aws_ec2_transit_gateway_route_table.test"
@@ -64,20 +1141,93 @@ func testAccTransitGatewayRouteTable_disappears(t *testing.T) {
 		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTransitGatewayRouteTableConfig_basic(rName),
+				Config: testAccTransitGatewayRouteTableConfig_timeouts(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTransitGatewayRouteTable(), resourceName),
 				),
-				ExpectNonEmptyPlan: true,
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
 			},
 		},
 	})
 }
 
-func testAccTransitGatewayRouteTable_disappears_TransitGateway(t *testing.T) {
+func testAccTransitGatewayRouteTable_setAsDefaultAssociation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	transitGatewayDataSourceName := "data.aws_ec2_transit_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_setAsDefaultAssociation(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "is_default_association", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "previous_default_association_route_table_id"),
+					resource.TestCheckResourceAttr(resourceName, "set_as_default_association", "true"),
+					resource.TestCheckResourceAttrPair(transitGatewayDataSourceName, "association_default_route_table_id", resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"association_count", "fetch_details", "has_blackhole_routes", "is_default_association", "is_default_propagation", "previous_default_association_route_table_id", "propagation_count", "set_as_default_association"},
+			},
+			{
+				Config: testAccTransitGatewayRouteTableConfig_setAsDefaultAssociation(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "is_default_association", "false"),
+					resource.TestCheckResourceAttr(resourceName, "previous_default_association_route_table_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "set_as_default_association", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTable_associationCount(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_fetchDetailsAssociation(rName),
+			},
+			{
+				// Refresh the route table after the association has been created.
+				Config: testAccTransitGatewayRouteTableConfig_fetchDetailsAssociation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "association_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "propagation_count", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTable_fetchDetails(t *testing.T) {
 	ctx := acctest.Context(t)
-	var transitGateway1 ec2.TransitGateway
 	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
 	resourceName := "aws_ec2_transit_gateway_route_table.test"
 	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
@@ -89,22 +1239,212 @@ func testAccTransitGatewayRouteTable_disappears_TransitGateway(t *testing.T) {
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
 		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_fetchDetails(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "association_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "fetch_details", "true"),
+					resource.TestCheckResourceAttr(resourceName, "has_blackhole_routes", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_default_association", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_default_propagation", "false"),
+					resource.TestCheckResourceAttr(resourceName, "propagation_count", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_owner_id", transitGatewayResourceName, "owner_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"association_count", "fetch_details", "has_blackhole_routes", "is_default_association", "is_default_propagation", "propagation_count"},
+			},
 			{
 				Config: testAccTransitGatewayRouteTableConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitGateway1),
 					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTransitGateway(), transitGatewayResourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "association_count"),
+					resource.TestCheckResourceAttr(resourceName, "fetch_details", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "has_blackhole_routes"),
+					resource.TestCheckNoResourceAttr(resourceName, "is_default_association"),
+					resource.TestCheckNoResourceAttr(resourceName, "is_default_propagation"),
+					resource.TestCheckNoResourceAttr(resourceName, "propagation_count"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_owner_id", transitGatewayResourceName, "owner_id"),
 				),
-				ExpectNonEmptyPlan: true,
 			},
 		},
 	})
 }
 
-func testAccTransitGatewayRouteTable_Tags(t *testing.T) {
+func testAccTransitGatewayRouteTable_blackholeRoutes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_blackholeRoute(rName),
+			},
+			{
+				// Refresh the route table after the route has been created.
+				Config: testAccTransitGatewayRouteTableConfig_blackholeRoute(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "has_blackhole_routes", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTable_routes(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTable1, transitGatewayRouteTable2 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	attachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_routes(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              "false",
+						"destination_cidr_block": "10.1.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.transit_gateway_attachment_id", attachmentResourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":                     "true",
+						"destination_cidr_block":        "10.2.0.0/16",
+						"transit_gateway_attachment_id": "",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"route"},
+			},
+			{
+				Config: testAccTransitGatewayRouteTableConfig_routesUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable2),
+					testAccCheckTransitGatewayRouteTableNotRecreated(&transitGatewayRouteTable1, &transitGatewayRouteTable2),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":                     "true",
+						"destination_cidr_block":        "10.1.0.0/16",
+						"transit_gateway_attachment_id": "",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              "false",
+						"destination_cidr_block": "10.3.0.0/16",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.transit_gateway_attachment_id", attachmentResourceName, "id"),
+				),
+			},
+			{
+				// A route managed by an aws_ec2_transit_gateway_route resource does not cause a diff.
+				Config: testAccTransitGatewayRouteTableConfig_routesSeparate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "route.#", "2"),
+				),
+			},
+			{
+				Config:   testAccTransitGatewayRouteTableConfig_routesSeparate(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTable_routesMixedFamily(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	attachmentResourceName := "aws_ec2_transit_gateway_vpc_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_routesMixedFamily(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "route.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              "false",
+						"destination_cidr_block": "0.0.0.0/0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":              "false",
+						"destination_cidr_block": "::/0",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "route.*.transit_gateway_attachment_id", attachmentResourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route.*", map[string]string{
+						"blackhole":                     "true",
+						"destination_cidr_block":        "2001:db8::/56",
+						"transit_gateway_attachment_id": "",
+					}),
+				),
+			},
+			{
+				Config:   testAccTransitGatewayRouteTableConfig_routesMixedFamily(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTable_forceDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var transitGatewayRouteTable1 ec2.TransitGatewayRouteTable
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTableConfig_forceDestroy(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
+					resource.TestCheckResourceAttr(resourceName, "force_destroy", "true"),
+					// Associate and propagate the attachments outside of Terraform.
+					testAccCheckTransitGatewayRouteTableAssociateAttachments(ctx, &transitGatewayRouteTable1, "aws_ec2_transit_gateway_vpc_attachment.test"),
+				),
+			},
+			{
+				// Destroying the route table removes the associations and propagations first.
+				Config: testAccTransitGatewayRouteTableConfig_forceDestroy(rName, false),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayRouteTable_createBeforeDestroy(t *testing.T) {
 	ctx := acctest.Context(t)
-	var transitGatewayRouteTable1, transitGatewayRouteTable2, transitGatewayRouteTable3 ec2.TransitGatewayRouteTable
+	var transitGatewayRouteTable1, transitGatewayRouteTable2 ec2.TransitGatewayRouteTable
 	resourceName := "aws_ec2_transit_gateway_route_table.test"
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -115,35 +1455,31 @@ func testAccTransitGatewayRouteTable_Tags(t *testing.T) {
 		CheckDestroy:             testAccCheckTransitGatewayRouteTableDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccTransitGatewayRouteTableConfig_tags1(rName, "key1", "value1"),
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccTransitGatewayRouteTableConfig_createBeforeDestroy(rName, 0),
+				),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable1),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-			{
-				Config: testAccTransitGatewayRouteTableConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable2),
-					testAccCheckTransitGatewayRouteTableNotRecreated(&transitGatewayRouteTable1, &transitGatewayRouteTable2),
-					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				Config: acctest.ConfigCompose(
+					acctest.ConfigDefaultTags_Tags1("providerkey1", "providervalue1"),
+					testAccTransitGatewayRouteTableConfig_createBeforeDestroy(rName, 1),
 				),
-			},
-			{
-				Config: testAccTransitGatewayRouteTableConfig_tags1(rName, "key2", "value2"),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable3),
-					testAccCheckTransitGatewayRouteTableNotRecreated(&transitGatewayRouteTable2, &transitGatewayRouteTable3),
+					testAccCheckTransitGatewayRouteTableExists(ctx, resourceName, &transitGatewayRouteTable2),
+					testAccCheckTransitGatewayRouteTableRecreated(&transitGatewayRouteTable1, &transitGatewayRouteTable2),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", "aws_ec2_transit_gateway.test.1", "id"),
 					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.providerkey1", "providervalue1"),
 				),
 			},
 		},
@@ -201,6 +1537,47 @@ func testAccCheckTransitGatewayRouteTableDestroy(ctx context.Context) resource.T
 	}
 }
 
+// testAccCheckTransitGatewayRouteTableAssociateAttachments associates the attachments with the specified resource name prefix
+// with the route table and enables their propagation to it.
+func testAccCheckTransitGatewayRouteTableAssociateAttachments(ctx context.Context, v *ec2.TransitGatewayRouteTable, prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn()
+		id := aws.StringValue(v.TransitGatewayRouteTableId)
+
+		for n, rs := range s.RootModule().Resources {
+			if !strings.HasPrefix(n, prefix+".") && n != prefix {
+				continue
+			}
+
+			attachmentID := rs.Primary.ID
+
+			if _, err := conn.AssociateTransitGatewayRouteTableWithContext(ctx, &ec2.AssociateTransitGatewayRouteTableInput{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				TransitGatewayRouteTableId: aws.String(id),
+			}); err != nil {
+				return err
+			}
+
+			if _, err := tfec2.WaitTransitGatewayRouteTableAssociationCreated(ctx, conn, id, attachmentID); err != nil {
+				return err
+			}
+
+			if _, err := conn.EnableTransitGatewayRouteTablePropagationWithContext(ctx, &ec2.EnableTransitGatewayRouteTablePropagationInput{
+				TransitGatewayAttachmentId: aws.String(attachmentID),
+				TransitGatewayRouteTableId: aws.String(id),
+			}); err != nil {
+				return err
+			}
+
+			if _, err := tfec2.WaitTransitGatewayRouteTablePropagationCreated(ctx, conn, id, attachmentID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckTransitGatewayRouteTableNotRecreated(i, j *ec2.TransitGatewayRouteTable) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if aws.StringValue(i.TransitGatewayRouteTableId) != aws.StringValue(j.TransitGatewayRouteTableId) {
@@ -211,6 +1588,26 @@ func testAccCheckTransitGatewayRouteTableNotRecreated(i, j *ec2.TransitGatewayRo
 	}
 }
 
+func testAccCheckTransitGatewayRouteTableDescriptionTag(v *ec2.TransitGatewayRouteTable, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.StringValue(tfec2.KeyValueTags(v.Tags).KeyValue("terraform-provider-aws:description")); got != expected {
+			return fmt.Errorf("EC2 Transit Gateway Route Table Description tag is %q, expected %q", got, expected)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTransitGatewayRouteTableRecreated(i, j *ec2.TransitGatewayRouteTable) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.TransitGatewayRouteTableId) == aws.StringValue(j.TransitGatewayRouteTableId) {
+			return errors.New("EC2 Transit Gateway Route Table was not recreated")
+		}
+
+		return nil
+	}
+}
+
 func testAccTransitGatewayRouteTableConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -225,6 +1622,303 @@ resource "aws_ec2_transit_gateway_route_table" "test" {
 `, rName)
 }
 
+func testAccTransitGatewayRouteTableConfig_fetchDetails(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  fetch_details      = true
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+}
+`, rName)
+}
+
+func testAccTransitGatewayRouteTableConfig_fetchDetailsAssociation(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableConfig_fetchDetails(rName), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  cidr_block = "10.0.0.0/24"
+  vpc_id     = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids                                      = [aws_subnet.test.id]
+  transit_gateway_default_route_table_association = false
+  transit_gateway_id                              = aws_ec2_transit_gateway.test.id
+  vpc_id                                          = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table_association" "test" {
+  transit_gateway_attachment_id  = aws_ec2_transit_gateway_vpc_attachment.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`, rName))
+}
+
+func testAccTransitGatewayRouteTableConfig_blackholeRoute(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableConfig_fetchDetails(rName), `
+resource "aws_ec2_transit_gateway_route" "test" {
+  destination_cidr_block         = "10.1.0.0/16"
+  blackhole                      = true
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableConfig_baseRoutes(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway" "test" {
+  default_route_table_association = "disable"
+  default_route_table_propagation = "disable"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  subnet_ids                                      = [aws_subnet.test.id]
+  transit_gateway_default_route_table_association = false
+  transit_gateway_default_route_table_propagation = false
+  transit_gateway_id                              = aws_ec2_transit_gateway.test.id
+  vpc_id                                          = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccTransitGatewayRouteTableConfig_routes(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableConfig_baseRoutes(rName), `
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  route {
+    destination_cidr_block        = "10.1.0.0/16"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  route {
+    destination_cidr_block = "10.2.0.0/16"
+    blackhole              = true
+  }
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableConfig_routesUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableConfig_baseRoutes(rName), `
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  route {
+    destination_cidr_block = "10.1.0.0/16"
+    blackhole              = true
+  }
+
+  route {
+    destination_cidr_block        = "10.3.0.0/16"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableConfig_routesSeparate(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableConfig_routesUpdated(rName), `
+resource "aws_ec2_transit_gateway_route" "test" {
+  destination_cidr_block         = "10.4.0.0/16"
+  transit_gateway_attachment_id  = aws_ec2_transit_gateway_vpc_attachment.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableConfig_routesMixedFamily(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableConfig_baseRoutes(rName), `
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  route {
+    destination_cidr_block        = "0.0.0.0/0"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  route {
+    destination_cidr_block        = "::/0"
+    transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+  }
+
+  route {
+    destination_cidr_block = "2001:db8::/56"
+    blackhole              = true
+  }
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableConfig_forceDestroy(rName string, routeTable bool) string {
+	config := acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  default_route_table_association = "disable"
+  default_route_table_propagation = "disable"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc" "test" {
+  count = 3
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 3
+
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.${count.index}.0.0/24"
+  vpc_id            = aws_vpc.test[count.index].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_vpc_attachment" "test" {
+  count = 3
+
+  subnet_ids                                      = [aws_subnet.test[count.index].id]
+  transit_gateway_default_route_table_association = false
+  transit_gateway_default_route_table_propagation = false
+  transit_gateway_id                              = aws_ec2_transit_gateway.test.id
+  vpc_id                                          = aws_vpc.test[count.index].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+
+	if !routeTable {
+		return config
+	}
+
+	return acctest.ConfigCompose(config, `
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  force_destroy      = true
+}
+`)
+}
+
+func testAccTransitGatewayRouteTableConfig_description(rName, description string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+  description        = %[2]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, description)
+}
+
+func testAccTransitGatewayRouteTableConfig_timeouts(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  timeouts {
+    create = "20m"
+  }
+}
+`, rName)
+}
+
+func testAccTransitGatewayRouteTableConfig_setAsDefaultAssociation(rName string, setAsDefaultAssociation bool) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [association_default_route_table_id]
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  fetch_details              = true
+  transit_gateway_id         = aws_ec2_transit_gateway.test.id
+  set_as_default_association = %[2]t
+}
+
+data "aws_ec2_transit_gateway" "test" {
+  id = aws_ec2_transit_gateway.test.id
+
+  depends_on = [aws_ec2_transit_gateway_route_table.test]
+}
+`, rName, setAsDefaultAssociation)
+}
+
 func testAccTransitGatewayRouteTableConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -261,3 +1955,27 @@ resource "aws_ec2_transit_gateway_route_table" "test" {
 }
 `, rName, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccTransitGatewayRouteTableConfig_createBeforeDestroy(rName string, index int) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  count = 2
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test[%[2]d].id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, rName, index)
+}
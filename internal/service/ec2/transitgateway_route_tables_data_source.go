@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func DataSourceTransitGatewayRouteTables() *schema.Resource {
@@ -29,6 +30,11 @@ func DataSourceTransitGatewayRouteTables() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"tags": tftags.TagsSchemaComputed(),
+			"transit_gateway_attachment_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validTransitGatewayAttachmentID,
+			},
 		},
 	}
 }
@@ -57,6 +63,14 @@ func dataSourceTransitGatewayRouteTablesRead(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Tables: %s", err)
 	}
 
+	if v, ok := d.GetOk("transit_gateway_attachment_id"); ok {
+		output, err = filterTransitGatewayRouteTablesByAssociation(ctx, conn, output, v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Route Table associations: %s", err)
+		}
+	}
+
 	var routeTableIDs []string
 
 	for _, v := range output {
@@ -68,3 +82,31 @@ func dataSourceTransitGatewayRouteTablesRead(ctx context.Context, d *schema.Reso
 
 	return diags
 }
+
+// filterTransitGatewayRouteTablesByAssociation returns the route table that the specified attachment is associated with, if any.
+// An attachment is associated with at most one route table, which is read from the attachment in a single call.
+func filterTransitGatewayRouteTablesByAssociation(ctx context.Context, conn *ec2.EC2, routeTables []*ec2.TransitGatewayRouteTable, transitGatewayAttachmentID string) ([]*ec2.TransitGatewayRouteTable, error) {
+	transitGatewayAttachment, err := FindTransitGatewayAttachmentByID(ctx, conn, transitGatewayAttachmentID)
+
+	if tfresource.NotFound(err) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	association := transitGatewayAttachment.Association
+
+	if association == nil || aws.StringValue(association.State) == ec2.TransitGatewayAssociationStateDisassociated {
+		return nil, nil
+	}
+
+	for _, v := range routeTables {
+		if aws.StringValue(v.TransitGatewayRouteTableId) == aws.StringValue(association.TransitGatewayRouteTableId) {
+			return []*ec2.TransitGatewayRouteTable{v}, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -1,13 +1,18 @@
 package ec2_test
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
 func testAccTransitGatewayRouteTablesDataSource_basic(t *testing.T) {
@@ -50,6 +55,28 @@ func testAccTransitGatewayRouteTablesDataSource_filter(t *testing.T) {
 	})
 }
 
+func testAccTransitGatewayRouteTablesDataSource_attachment(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_ec2_transit_gateway_route_tables.test"
+	resourceName := "aws_ec2_transit_gateway_route_table.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayRouteTablesDataSourceConfig_attachment(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "ids.*", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTransitGatewayRouteTablesDataSource_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -174,3 +201,129 @@ data "aws_ec2_transit_gateway_route_tables" "test" {
 }
 `, rName)
 }
+
+func testAccTransitGatewayRouteTablesDataSourceConfig_attachment(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableAssociationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway_route_table" "other" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_transit_gateway_route_tables" "test" {
+  filter {
+    name   = "transit-gateway-id"
+    values = [aws_ec2_transit_gateway.test.id]
+  }
+
+  transit_gateway_attachment_id = aws_ec2_transit_gateway_vpc_attachment.test.id
+
+  depends_on = [
+    aws_ec2_transit_gateway_route_table.other,
+    aws_ec2_transit_gateway_route_table_association.test,
+  ]
+}
+`, rName))
+}
+
+func TestFilterTransitGatewayRouteTablesByAssociation(t *testing.T) {
+	t.Parallel()
+
+	const attachmentID = "tgw-attach-12345678"
+
+	testCases := map[string]struct {
+		association *ec2.TransitGatewayAttachmentAssociation
+		expected    []string
+	}{
+		"none": {},
+		"associated": {
+			association: &ec2.TransitGatewayAttachmentAssociation{
+				State:                      aws.String(ec2.TransitGatewayAssociationStateAssociated),
+				TransitGatewayRouteTableId: aws.String("tgw-rtb-22222222"),
+			},
+			expected: []string{"tgw-rtb-22222222"},
+		},
+		"associating": {
+			association: &ec2.TransitGatewayAttachmentAssociation{
+				State:                      aws.String(ec2.TransitGatewayAssociationStateAssociating),
+				TransitGatewayRouteTableId: aws.String("tgw-rtb-11111111"),
+			},
+			expected: []string{"tgw-rtb-11111111"},
+		},
+		"disassociated": {
+			association: &ec2.TransitGatewayAttachmentAssociation{
+				State:                      aws.String(ec2.TransitGatewayAssociationStateDisassociated),
+				TransitGatewayRouteTableId: aws.String("tgw-rtb-11111111"),
+			},
+		},
+		"not filtered": {
+			association: &ec2.TransitGatewayAttachmentAssociation{
+				State:                      aws.String(ec2.TransitGatewayAssociationStateAssociated),
+				TransitGatewayRouteTableId: aws.String("tgw-rtb-33333333"),
+			},
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var requests int
+			conn := ec2.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				requests++
+
+				output, ok := r.Data.(*ec2.DescribeTransitGatewayAttachmentsOutput)
+				if !ok {
+					r.Error = fmt.Errorf("unexpected request: %s", r.Operation.Name)
+					return
+				}
+
+				output.TransitGatewayAttachments = []*ec2.TransitGatewayAttachment{{
+					Association:                testCase.association,
+					TransitGatewayAttachmentId: aws.String(attachmentID),
+				}}
+			})
+
+			routeTables := []*ec2.TransitGatewayRouteTable{
+				{TransitGatewayRouteTableId: aws.String("tgw-rtb-11111111")},
+				{TransitGatewayRouteTableId: aws.String("tgw-rtb-22222222")},
+			}
+
+			output, err := tfec2.FilterTransitGatewayRouteTablesByAssociation(context.Background(), conn, routeTables, attachmentID)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if requests != 1 {
+				t.Errorf("expected 1 request, got %d", requests)
+			}
+
+			var got []string
+			for _, v := range output {
+				got = append(got, aws.StringValue(v.TransitGatewayRouteTableId))
+			}
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("expected %v, got %v", testCase.expected, got)
+			}
+
+			for i := range got {
+				if got[i] != testCase.expected[i] {
+					t.Errorf("expected %v, got %v", testCase.expected, got)
+				}
+			}
+		})
+	}
+}
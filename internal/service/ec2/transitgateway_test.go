@@ -86,6 +86,7 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"basic":            testAccTransitGatewayPeeringAttachmentAccepter_basic,
 			"DifferentAccount": testAccTransitGatewayPeeringAttachmentAccepter_differentAccount,
 			"Tags":             testAccTransitGatewayPeeringAttachmentAccepter_Tags,
+			"Timeouts":         testAccTransitGatewayPeeringAttachmentAccepter_timeouts,
 		},
 		"PolicyTable": {
 			"basic":                    testAccTransitGatewayPolicyTable_basic,
@@ -112,9 +113,19 @@ func TestAccTransitGateway_serial(t *testing.T) {
 		},
 		"RouteTable": {
 			"basic":                    testAccTransitGatewayRouteTable_basic,
+			"AssociationCount":         testAccTransitGatewayRouteTable_associationCount,
+			"BlackholeRoutes":          testAccTransitGatewayRouteTable_blackholeRoutes,
 			"disappears":               testAccTransitGatewayRouteTable_disappears,
 			"disappearsTransitGateway": testAccTransitGatewayRouteTable_disappears_TransitGateway,
+			"ForceDestroy":             testAccTransitGatewayRouteTable_forceDestroy,
+			"Routes":                   testAccTransitGatewayRouteTable_routes,
+			"RoutesMixedFamily":        testAccTransitGatewayRouteTable_routesMixedFamily,
 			"Tags":                     testAccTransitGatewayRouteTable_Tags,
+			"CreateBeforeDestroy":      testAccTransitGatewayRouteTable_createBeforeDestroy,
+			"Description":              testAccTransitGatewayRouteTable_description,
+			"FetchDetails":             testAccTransitGatewayRouteTable_fetchDetails,
+			"SetAsDefaultAssociation":  testAccTransitGatewayRouteTable_setAsDefaultAssociation,
+			"Timeouts":                 testAccTransitGatewayRouteTable_timeouts,
 		},
 		"RouteTableAssociation": {
 			"basic":      testAccTransitGatewayRouteTableAssociation_basic,
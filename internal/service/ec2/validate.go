@@ -64,3 +64,17 @@ func validAmazonSideASN(v interface{}, k string) (ws []string, errors []error) {
 	}
 	return
 }
+
+func validTransitGatewayAttachmentID(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	// Transit gateway IDs (tgw-...) are a common mistake for attachment IDs.
+	pattern := `^tgw-attach-[0-9a-f]+$`
+	if !regexp.MustCompile(pattern).MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q doesn't look like an EC2 Transit Gateway Attachment ID (tgw-attach-...): %q",
+			k, value))
+	}
+
+	return
+}
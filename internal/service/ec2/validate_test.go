@@ -78,3 +78,33 @@ func TestValidAmazonSideASN(t *testing.T) {
 		}
 	}
 }
+
+func TestValidTransitGatewayAttachmentID(t *testing.T) {
+	t.Parallel()
+
+	validIDs := []string{
+		"tgw-attach-12345678",
+		"tgw-attach-0123456789abcdef0",
+	}
+	for _, v := range validIDs {
+		_, errors := validTransitGatewayAttachmentID(v, "transit_gateway_attachment_id")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid transit gateway attachment ID: %q", v, errors)
+		}
+	}
+
+	invalidIDs := []string{
+		"",
+		"tgw-0123456789abcdef0",
+		"tgw-attach-",
+		"tgw-attach-XYZ",
+		"tgw-rtb-0123456789abcdef0",
+		" tgw-attach-12345678",
+	}
+	for _, v := range invalidIDs {
+		_, errors := validTransitGatewayAttachmentID(v, "transit_gateway_attachment_id")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid transit gateway attachment ID", v)
+		}
+	}
+}
@@ -302,6 +302,7 @@ func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	// Routes are not taggable, so route table tags are only ever applied to the route table itself.
 	if d.HasChange("route") {
 		o, n := d.GetChange("route")
 
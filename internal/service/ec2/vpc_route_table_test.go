@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -18,6 +20,61 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+// TestRouteTableRouteReconciliation_noTags guards against route table tags being propagated
+// to inline routes, which are not taggable.
+func TestRouteTableRouteReconciliation_noTags(t *testing.T) {
+	ctx := context.Background()
+	const (
+		routeTableID = "rtb-12345678"
+		destination  = "10.1.0.0/16"
+	)
+	var routes []*ec2.Route
+	var operations []string
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := ec2.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		operations = append(operations, r.Operation.Name)
+
+		switch input := r.Params.(type) {
+		case *ec2.CreateRouteInput:
+			routes = []*ec2.Route{{DestinationCidrBlock: input.DestinationCidrBlock, GatewayId: input.GatewayId}}
+		case *ec2.ReplaceRouteInput:
+			routes = []*ec2.Route{{DestinationCidrBlock: input.DestinationCidrBlock, GatewayId: input.GatewayId}}
+		case *ec2.DeleteRouteInput:
+			routes = nil
+		case *ec2.DescribeRouteTablesInput:
+			r.Data.(*ec2.DescribeRouteTablesOutput).RouteTables = []*ec2.RouteTable{{
+				RouteTableId: aws.String(routeTableID),
+				Routes:       routes,
+			}}
+		}
+	})
+
+	if err := tfec2.RouteTableAddRoute(ctx, conn, routeTableID, map[string]interface{}{"cidr_block": destination, "gateway_id": "igw-12345678"}, time.Minute); err != nil {
+		t.Fatalf("adding route: %s", err)
+	}
+
+	if err := tfec2.RouteTableUpdateRoute(ctx, conn, routeTableID, map[string]interface{}{"cidr_block": destination, "gateway_id": "vgw-12345678"}, time.Minute); err != nil {
+		t.Fatalf("updating route: %s", err)
+	}
+
+	if err := tfec2.RouteTableDeleteRoute(ctx, conn, routeTableID, map[string]interface{}{"cidr_block": destination, "gateway_id": "vgw-12345678"}, time.Minute); err != nil {
+		t.Fatalf("deleting route: %s", err)
+	}
+
+	for _, v := range operations {
+		if v == "CreateTags" || v == "DeleteTags" {
+			t.Errorf("unexpected %s call during route reconciliation", v)
+		}
+	}
+}
+
 func TestAccVPCRouteTable_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var routeTable ec2.RouteTable
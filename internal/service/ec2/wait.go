@@ -1209,14 +1209,13 @@ func WaitTransitGatewayMulticastDomainAssociationDeleted(ctx context.Context, co
 const (
 	TransitGatewayPeeringAttachmentCreatedTimeout = 10 * time.Minute
 	TransitGatewayPeeringAttachmentDeletedTimeout = 10 * time.Minute
-	TransitGatewayPeeringAttachmentUpdatedTimeout = 10 * time.Minute
 )
 
-func WaitTransitGatewayPeeringAttachmentAccepted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayPeeringAttachment, error) {
+func WaitTransitGatewayPeeringAttachmentAccepted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayPeeringAttachment, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.TransitGatewayAttachmentStatePending, ec2.TransitGatewayAttachmentStatePendingAcceptance},
 		Target:  []string{ec2.TransitGatewayAttachmentStateAvailable},
-		Timeout: TransitGatewayPeeringAttachmentUpdatedTimeout,
+		Timeout: timeout,
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
@@ -1254,7 +1253,7 @@ func WaitTransitGatewayPeeringAttachmentCreated(ctx context.Context, conn *ec2.E
 	return nil, err
 }
 
-func WaitTransitGatewayPeeringAttachmentDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayPeeringAttachment, error) {
+func WaitTransitGatewayPeeringAttachmentDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayPeeringAttachment, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{
 			ec2.TransitGatewayAttachmentStateAvailable,
@@ -1263,7 +1262,7 @@ func WaitTransitGatewayPeeringAttachmentDeleted(ctx context.Context, conn *ec2.E
 			ec2.TransitGatewayAttachmentStateRejecting,
 		},
 		Target:  []string{ec2.TransitGatewayAttachmentStateDeleted},
-		Timeout: TransitGatewayPeeringAttachmentDeletedTimeout,
+		Timeout: timeout,
 		Refresh: StatusTransitGatewayPeeringAttachmentState(ctx, conn, id),
 	}
 
@@ -1375,10 +1374,9 @@ func WaitTransitGatewayRouteDeleted(ctx context.Context, conn *ec2.EC2, transitG
 }
 
 const (
-	TransitGatewayRouteTableCreatedTimeout  = 10 * time.Minute
-	TransitGatewayRouteTableDeletedTimeout  = 10 * time.Minute
-	TransitGatewayPolicyTableCreatedTimeout = 10 * time.Minute
-	TransitGatewayPolicyTableDeletedTimeout = 10 * time.Minute
+	TransitGatewayRouteTableAvailableTimeout = 10 * time.Minute
+	TransitGatewayPolicyTableCreatedTimeout  = 10 * time.Minute
+	TransitGatewayPolicyTableDeletedTimeout  = 10 * time.Minute
 )
 
 func WaitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayPolicyTable, error) {
@@ -1398,12 +1396,15 @@ func WaitTransitGatewayPolicyTableCreated(ctx context.Context, conn *ec2.EC2, id
 	return nil, err
 }
 
-func WaitTransitGatewayRouteTableCreated(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayRouteTable, error) {
+// WaitTransitGatewayRouteTableAvailable waits for the specified transit gateway route table to become available,
+// e.g. before a transit gateway attachment is associated with it. A route table that is being deleted or has been deleted
+// never becomes available, so those states fail immediately.
+func WaitTransitGatewayRouteTableAvailable(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayRouteTable, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.TransitGatewayRouteTableStatePending},
 		Target:  []string{ec2.TransitGatewayRouteTableStateAvailable},
-		Timeout: TransitGatewayRouteTableCreatedTimeout,
-		Refresh: StatusTransitGatewayRouteTableState(ctx, conn, id),
+		Timeout: timeout,
+		Refresh: StatusTransitGatewayRouteTableStateIncludingDeleted(ctx, conn, id),
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -1432,11 +1433,11 @@ func WaitTransitGatewayPolicyTableDeleted(ctx context.Context, conn *ec2.EC2, id
 	return nil, err
 }
 
-func WaitTransitGatewayRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id string) (*ec2.TransitGatewayRouteTable, error) {
+func WaitTransitGatewayRouteTableDeleted(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.TransitGatewayRouteTable, error) {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{ec2.TransitGatewayRouteTableStateAvailable, ec2.TransitGatewayRouteTableStateDeleting},
 		Target:  []string{},
-		Timeout: TransitGatewayRouteTableDeletedTimeout,
+		Timeout: timeout,
 		Refresh: StatusTransitGatewayRouteTableState(ctx, conn, id),
 	}
 
@@ -2,17 +2,27 @@ package elasticbeanstalk
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"regexp"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 func ResourceConfigurationTemplate() *schema.Resource {
@@ -22,12 +32,26 @@ func ResourceConfigurationTemplate() *schema.Resource {
 		UpdateWithoutTimeout: resourceConfigurationTemplateUpdate,
 		DeleteWithoutTimeout: resourceConfigurationTemplateDelete,
 
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceConfigurationTemplateImport,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffValidateOptionSettingValues,
+			resourceConfigurationTemplateCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"application": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -37,11 +61,65 @@ func ResourceConfigurationTemplate() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"environment_variables": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"fetch_platform_details": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"name": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"platform_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"operating_system_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operating_system_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform_branch_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform_lifecycle_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"programming_language": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"version": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"setting": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -49,18 +127,47 @@ func ResourceConfigurationTemplate() *schema.Resource {
 				Elem:     settingSchema(),
 				Set:      optionSettingValueHash,
 			},
+			"settings_map": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"solution_stack_name": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressEquivalentSolutionStackNames,
 			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
 		},
 	}
 }
 
+func resourceConfigurationTemplateCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" && diff.HasChange("fetch_platform_details") {
+		if err := diff.SetNewComputed("platform_details"); err != nil {
+			return err
+		}
+	}
+
+	if diff.Id() != "" && diff.HasChange("setting") {
+		if err := diff.SetNewComputed("environment_variables"); err != nil {
+			return err
+		}
+
+		return diff.SetNewComputed("settings_map")
+	}
+
+	return nil
+}
+
 func resourceConfigurationTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	// Get the relevant properties
 	name := d.Get("name").(string)
@@ -68,10 +175,15 @@ func resourceConfigurationTemplateCreate(ctx context.Context, d *schema.Resource
 
 	optionSettings := gatherOptionSettings(d)
 
+	// Option settings can only be validated against an existing template,
+	// so the template is created without them and updated once they are validated.
 	opts := elasticbeanstalk.CreateConfigurationTemplateInput{
 		ApplicationName: aws.String(appName),
 		TemplateName:    aws.String(name),
-		OptionSettings:  optionSettings,
+	}
+
+	if len(tags) > 0 {
+		opts.Tags = Tags(tags.IgnoreElasticbeanstalk())
 	}
 
 	if attr, ok := d.GetOk("description"); ok {
@@ -86,19 +198,67 @@ func resourceConfigurationTemplateCreate(ctx context.Context, d *schema.Resource
 		opts.SolutionStackName = aws.String(attr.(string))
 	}
 
-	log.Printf("[DEBUG] Elastic Beanstalk configuration template create opts: %s", opts)
-	if _, err := conn.CreateConfigurationTemplateWithContext(ctx, &opts); err != nil {
+	log.Printf("[DEBUG] Creating Elastic Beanstalk Configuration Template (%s) with option settings: %s", name, optionSettingsJSON(optionSettings))
+	_, err := newRetryBudgetFromEnv().retryWhenThrottled(ctx, func() (interface{}, error) {
+		return conn.CreateConfigurationTemplateWithContext(ctx, &opts)
+	})
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Elastic Beanstalk configuration template: %s", err)
 	}
 
 	d.SetId(name)
 
+	if len(optionSettings) > 0 {
+		output, err := conn.ValidateConfigurationSettingsWithContext(ctx, &elasticbeanstalk.ValidateConfigurationSettingsInput{
+			ApplicationName: aws.String(appName),
+			TemplateName:    aws.String(name),
+			OptionSettings:  optionSettings,
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "validating Elastic Beanstalk Configuration Template (%s) settings: %s", d.Id(), err)
+		}
+
+		if validationDiags := configurationTemplateValidationDiags(output.Messages); validationDiags.HasError() {
+			diags = append(diags, validationDiags...)
+
+			// Don't leave behind a template that the settings were never applied to.
+			if _, err := conn.DeleteConfigurationTemplateWithContext(ctx, &elasticbeanstalk.DeleteConfigurationTemplateInput{
+				ApplicationName: aws.String(appName),
+				TemplateName:    aws.String(name),
+			}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting Elastic Beanstalk Configuration Template (%s): %s", d.Id(), err)
+			}
+
+			d.SetId("")
+
+			return diags
+		}
+
+		input := &elasticbeanstalk.UpdateConfigurationTemplateInput{
+			ApplicationName: aws.String(appName),
+			TemplateName:    aws.String(name),
+			OptionSettings:  optionSettings,
+		}
+
+		_, err = newRetryBudgetFromEnv().retryWhenThrottled(ctx, func() (interface{}, error) {
+			return conn.UpdateConfigurationTemplateWithContext(ctx, input)
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Elastic Beanstalk Configuration Template (%s) settings: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceConfigurationTemplateRead(ctx, d, meta)...)
 }
 
 func resourceConfigurationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	settings, err := FindConfigurationSettingsByTwoPartKey(ctx, conn, d.Get("application").(string), d.Id())
 
@@ -112,11 +272,51 @@ func resourceConfigurationTemplateRead(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Configuration Template (%s): %s", d.Id(), err)
 	}
 
+	arn := configurationTemplateARN(meta.(*conns.AWSClient), aws.StringValue(settings.ApplicationName), d.Id())
 	d.Set("application", settings.ApplicationName)
-	d.Set("description", settings.Description)
+	d.Set("arn", arn)
+	// A template without a description has a nil description.
+	d.Set("description", aws.StringValue(settings.Description))
+	d.Set("environment_variables", flattenEnvironmentVariables(settings.OptionSettings))
 	d.Set("name", settings.TemplateName)
+	if err := d.Set("setting", configuredOptionSettings(settings.OptionSettings, d.Get("setting").(*schema.Set)).List()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
+	}
+	d.Set("settings_map", flattenConfigurationOptionSettingsMap(settings.OptionSettings))
 	d.Set("solution_stack_name", settings.SolutionStackName)
 
+	// Platform details take two more API calls, so they are only read when requested.
+	if d.Get("fetch_platform_details").(bool) {
+		platform, err := findConfigurationTemplatePlatformByTwoPartKey(ctx, conn, d.Get("application").(string), d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Configuration Template (%s) platform details: %s", d.Id(), err)
+		}
+
+		if err := d.Set("platform_details", flattenPlatformDescription(platform)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting platform_details: %s", err)
+		}
+	} else {
+		d.Set("platform_details", nil)
+	}
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for Elastic Beanstalk Configuration Template (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreElasticbeanstalk().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
 	return diags
 }
 
@@ -138,14 +338,25 @@ func resourceConfigurationTemplateUpdate(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	if d.HasChange("tags_all") {
+		arn := d.Get("arn").(string)
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, arn, o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Elastic Beanstalk Configuration Template (%s) tags: %s", arn, err)
+		}
+	}
+
 	return append(diags, resourceConfigurationTemplateRead(ctx, d, meta)...)
 }
 
 func resourceConfigurationTemplateDescriptionUpdate(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, d *schema.ResourceData) error {
-	_, err := conn.UpdateConfigurationTemplateWithContext(ctx, &elasticbeanstalk.UpdateConfigurationTemplateInput{
-		ApplicationName: aws.String(d.Get("application").(string)),
-		TemplateName:    aws.String(d.Get("name").(string)),
-		Description:     aws.String(d.Get("description").(string)),
+	_, err := newRetryBudgetFromEnv().retryWhenThrottled(ctx, func() (interface{}, error) {
+		return conn.UpdateConfigurationTemplateWithContext(ctx, &elasticbeanstalk.UpdateConfigurationTemplateInput{
+			ApplicationName: aws.String(d.Get("application").(string)),
+			TemplateName:    aws.String(d.Get("name").(string)),
+			Description:     aws.String(d.Get("description").(string)),
+		})
 	})
 
 	return err
@@ -153,46 +364,28 @@ func resourceConfigurationTemplateDescriptionUpdate(ctx context.Context, conn *e
 
 func resourceConfigurationTemplateOptionSettingsUpdate(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, d *schema.ResourceData) error {
 	if d.HasChange("setting") {
-		_, err := conn.ValidateConfigurationSettingsWithContext(ctx, &elasticbeanstalk.ValidateConfigurationSettingsInput{
+		o, n := d.GetChange("setting")
+		add, remove := DiffOptionSettings(o.(*schema.Set), n.(*schema.Set))
+
+		// Settings are compared by their hashes, which ignore the order of comma-separated values,
+		// so reordering the configuration without changing any values leaves nothing to update.
+		if len(add) == 0 && len(remove) == 0 {
+			return nil
+		}
+
+		optionSettings := gatherOptionSettings(d)
+		output, err := conn.ValidateConfigurationSettingsWithContext(ctx, &elasticbeanstalk.ValidateConfigurationSettingsInput{
 			ApplicationName: aws.String(d.Get("application").(string)),
 			TemplateName:    aws.String(d.Get("name").(string)),
-			OptionSettings:  gatherOptionSettings(d),
+			OptionSettings:  optionSettings,
 		})
 		if err != nil {
 			return err
 		}
 
-		o, n := d.GetChange("setting")
-		if o == nil {
-			o = new(schema.Set)
-		}
-		if n == nil {
-			n = new(schema.Set)
-		}
-
-		os := o.(*schema.Set)
-		ns := n.(*schema.Set)
-
-		rm := extractOptionSettings(os.Difference(ns))
-		add := extractOptionSettings(ns.Difference(os))
-
-		// Additions and removals of options are done in a single API call, so we
-		// can't do our normal "remove these" and then later "add these", re-adding
-		// any updated settings.
-		// Because of this, we need to remove any settings in the "removable"
-		// settings that are also found in the "add" settings, otherwise they
-		// conflict. Here we loop through all the initial removables from the set
-		// difference, and we build up a slice of settings not found in the "add"
-		// set
-		var remove []*elasticbeanstalk.ConfigurationOptionSetting
-		for _, r := range rm {
-			for _, a := range add {
-				if aws.StringValue(r.Namespace) == aws.StringValue(a.Namespace) &&
-					aws.StringValue(r.OptionName) == aws.StringValue(a.OptionName) {
-					continue
-				}
-				remove = append(remove, r)
-			}
+		// As on create, settings that fail validation are not applied.
+		if validationDiags := configurationTemplateValidationDiags(output.Messages); validationDiags.HasError() {
+			return sdkdiag.DiagnosticsError(validationDiags)
 		}
 
 		req := &elasticbeanstalk.UpdateConfigurationTemplateInput{
@@ -208,8 +401,12 @@ func resourceConfigurationTemplateOptionSettingsUpdate(ctx context.Context, conn
 			})
 		}
 
-		log.Printf("[DEBUG] Update Configuration Template request: %s", req)
-		if _, err := conn.UpdateConfigurationTemplateWithContext(ctx, req); err != nil {
+		log.Printf("[DEBUG] Updating Elastic Beanstalk Configuration Template (%s) option settings: %s, removing: %s", d.Id(), optionSettingsJSON(add), optionSettingsJSON(remove))
+		_, err = newRetryBudgetFromEnv().retryWhenThrottled(ctx, func() (interface{}, error) {
+			return conn.UpdateConfigurationTemplateWithContext(ctx, req)
+		})
+
+		if err != nil {
 			return err
 		}
 	}
@@ -233,13 +430,68 @@ func resourceConfigurationTemplateDelete(ctx context.Context, d *schema.Resource
 	return diags
 }
 
+func resourceConfigurationTemplateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	const importIDSeparator = "/"
+	// The template name may itself contain the separator, so only split on the first one.
+	parts := strings.SplitN(d.Id(), importIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format for ID (%[1]s), expected APPLICATION%[2]sTEMPLATE_NAME", d.Id(), importIDSeparator)
+	}
+
+	applicationName, templateName := parts[0], parts[1]
+	d.SetId(templateName)
+	d.Set("application", applicationName)
+	d.Set("fetch_platform_details", false)
+	d.Set("name", templateName)
+
+	// Verification is best effort: importers cannot return warnings, and a missing template is reported by Read.
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	if settings, err := FindConfigurationSettingsByTwoPartKey(ctx, conn, applicationName, templateName); err != nil {
+		log.Printf("[WARN] Unable to verify imported Elastic Beanstalk Configuration Template (%s) settings: %s", templateName, err)
+	} else if warning := configurationTemplateImportWarning(settings); warning != "" {
+		log.Printf("[WARN] %s", warning)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// configurationTemplateImportWarning returns a warning if an imported template's settings look incomplete.
+// Elastic Beanstalk always resolves a template's settings against its platform, so a template without
+// any settings, or with settings lacking a namespace or name, usually indicates an AWS-side inconsistency.
+func configurationTemplateImportWarning(settings *elasticbeanstalk.ConfigurationSettingsDescription) string {
+	templateName := aws.StringValue(settings.TemplateName)
+
+	if len(settings.OptionSettings) == 0 {
+		return fmt.Sprintf("Elastic Beanstalk Configuration Template (%s) has no option settings and may be an empty shell", templateName)
+	}
+
+	var n int
+
+	for _, v := range settings.OptionSettings {
+		if v == nil || aws.StringValue(v.Namespace) == "" || aws.StringValue(v.OptionName) == "" {
+			n++
+		}
+	}
+
+	if n > 0 {
+		return fmt.Sprintf("Elastic Beanstalk Configuration Template (%s) has %d option settings without a namespace or name", templateName, n)
+	}
+
+	return ""
+}
+
 func FindConfigurationSettingsByTwoPartKey(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, applicationName, templateName string) (*elasticbeanstalk.ConfigurationSettingsDescription, error) {
 	input := &elasticbeanstalk.DescribeConfigurationSettingsInput{
 		ApplicationName: aws.String(applicationName),
 		TemplateName:    aws.String(templateName),
 	}
 
-	output, err := conn.DescribeConfigurationSettingsWithContext(ctx, input)
+	// Reads are retried when throttled so that refreshing many templates at once doesn't fail the plan.
+	outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, readThrottleTimeout, func() (interface{}, error) {
+		return conn.DescribeConfigurationSettingsWithContext(ctx, input)
+	}, errCodeThrottling, errCodeTooManyRequestsException)
 
 	if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "No Configuration Template named") || tfawserr.ErrMessageContains(err, "InvalidParameterValue", "No Application named") {
 		return nil, &resource.NotFoundError{
@@ -252,6 +504,8 @@ func FindConfigurationSettingsByTwoPartKey(ctx context.Context, conn *elasticbea
 		return nil, err
 	}
 
+	output := outputRaw.(*elasticbeanstalk.DescribeConfigurationSettingsOutput)
+
 	if output == nil || len(output.ConfigurationSettings) == 0 || output.ConfigurationSettings[0] == nil {
 		return nil, tfresource.NewEmptyResultError(input)
 	}
@@ -263,6 +517,121 @@ func FindConfigurationSettingsByTwoPartKey(ctx context.Context, conn *elasticbea
 	return output.ConfigurationSettings[0], nil
 }
 
+// findConfigurationTemplatePlatformByTwoPartKey returns the description of the platform that a configuration template runs on,
+// whether the template specifies a solution stack or a platform.
+func findConfigurationTemplatePlatformByTwoPartKey(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, applicationName, templateName string) (*elasticbeanstalk.PlatformDescription, error) {
+	input := &elasticbeanstalk.DescribeConfigurationOptionsInput{
+		ApplicationName: aws.String(applicationName),
+		TemplateName:    aws.String(templateName),
+	}
+
+	output, err := conn.DescribeConfigurationOptionsWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "No Configuration Template named") || tfawserr.ErrMessageContains(err, "InvalidParameterValue", "No Application named") {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || aws.StringValue(output.PlatformArn) == "" {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return findPlatformVersionByARN(ctx, conn, aws.StringValue(output.PlatformArn))
+}
+
+func findPlatformVersionByARN(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, arn string) (*elasticbeanstalk.PlatformDescription, error) {
+	input := &elasticbeanstalk.DescribePlatformVersionInput{
+		PlatformArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribePlatformVersionWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PlatformDescription == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PlatformDescription, nil
+}
+
+// configuredOptionSettings returns the current values of the configured option settings.
+// Elastic Beanstalk returns every option setting, including the many defaults it injects,
+// so only settings whose namespace, name and resource are configured are kept.
+func configuredOptionSettings(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting, configured *schema.Set) *schema.Set {
+	current := make([]interface{}, 0, len(apiObjects))
+
+	for _, tfMapRaw := range flattenConfigurationOptionSettings(apiObjects) {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if tfMap["namespace"] != "aws:autoscaling:scheduledaction" {
+			delete(tfMap, "resource")
+		}
+
+		switch tfMap["name"] {
+		case "Subnets", "ELBSubnets":
+			tfMap["value"] = sortValues(tfMap["value"].(string))
+		}
+
+		current = append(current, tfMap)
+	}
+
+	currentKeySet := schema.NewSet(optionSettingKeyHash, current)
+	configuredKeySet := schema.NewSet(optionSettingKeyHash, configured.List())
+
+	return schema.NewSet(optionSettingValueHash, currentKeySet.Intersection(configuredKeySet).List())
+}
+
+// configurationTemplateARN returns the ARN of the specified configuration template.
+// The application and template names are used verbatim in the resource path.
+func configurationTemplateARN(client *conns.AWSClient, applicationName, templateName string) string {
+	return arn.ARN{
+		Partition: client.Partition,
+		Service:   elasticbeanstalk.ServiceName,
+		Region:    client.Region,
+		AccountID: client.AccountID,
+		Resource:  fmt.Sprintf("configurationtemplate/%s/%s", applicationName, templateName),
+	}.String()
+}
+
+// solutionStackNameVersionRegexp matches a platform version, e.g. "v3.4.0", in a solution stack name.
+var solutionStackNameVersionRegexp = regexp.MustCompile(`\s+v\d+(\.\d+)*(\s|$)`)
+
+// normalizeSolutionStackName collapses runs of whitespace in a solution stack name and
+// reports the name with any platform version, e.g. "v3.4.0", removed.
+func normalizeSolutionStackName(s string) (normalized, unversioned string) {
+	normalized = strings.Join(strings.Fields(s), " ")
+	unversioned = strings.Join(strings.Fields(solutionStackNameVersionRegexp.ReplaceAllString(normalized, " ")), " ")
+
+	return normalized, unversioned
+}
+
+// suppressEquivalentSolutionStackNames suppresses differences in whitespace between solution stack names, and
+// differences where only one of the names includes a platform version. Differing platform versions are not suppressed.
+func suppressEquivalentSolutionStackNames(_, old, new string, _ *schema.ResourceData) bool {
+	oldNormalized, oldUnversioned := normalizeSolutionStackName(old)
+	newNormalized, newUnversioned := normalizeSolutionStackName(new)
+
+	if oldNormalized == newNormalized {
+		return true
+	}
+
+	if oldUnversioned != newUnversioned {
+		return false
+	}
+
+	// The names only differ by platform version, which is equivalent if one of them omits it.
+	return oldNormalized == oldUnversioned || newNormalized == newUnversioned
+}
+
 func gatherOptionSettings(d *schema.ResourceData) []*elasticbeanstalk.ConfigurationOptionSetting {
 	optionSettingsSet, ok := d.Get("setting").(*schema.Set)
 	if !ok || optionSettingsSet == nil {
@@ -271,3 +640,69 @@ func gatherOptionSettings(d *schema.ResourceData) []*elasticbeanstalk.Configurat
 
 	return extractOptionSettings(optionSettingsSet)
 }
+
+// optionSettingsJSON returns the option settings as compact JSON for logging, sorted by namespace, option name and resource name.
+func optionSettingsJSON(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting) string {
+	type optionSetting struct {
+		Namespace    string `json:"namespace"`
+		OptionName   string `json:"name"`
+		ResourceName string `json:"resource,omitempty"`
+		Value        string `json:"value"`
+	}
+
+	optionSettings := make([]optionSetting, 0, len(apiObjects))
+
+	for _, v := range apiObjects {
+		if v == nil {
+			continue
+		}
+
+		optionSettings = append(optionSettings, optionSetting{
+			Namespace:    aws.StringValue(v.Namespace),
+			OptionName:   aws.StringValue(v.OptionName),
+			ResourceName: aws.StringValue(v.ResourceName),
+			Value:        aws.StringValue(v.Value),
+		})
+	}
+
+	sort.Slice(optionSettings, func(i, j int) bool {
+		if optionSettings[i].Namespace != optionSettings[j].Namespace {
+			return optionSettings[i].Namespace < optionSettings[j].Namespace
+		}
+
+		if optionSettings[i].OptionName != optionSettings[j].OptionName {
+			return optionSettings[i].OptionName < optionSettings[j].OptionName
+		}
+
+		return optionSettings[i].ResourceName < optionSettings[j].ResourceName
+	})
+
+	b, err := json.Marshal(optionSettings)
+
+	if err != nil {
+		return fmt.Sprintf("error marshaling option settings: %s", err)
+	}
+
+	return string(b)
+}
+
+// configurationTemplateValidationDiags returns an error diagnostic for each error returned by ValidateConfigurationSettings.
+// Warnings are only logged.
+func configurationTemplateValidationDiags(messages []*elasticbeanstalk.ValidationMessage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, v := range messages {
+		if v == nil {
+			continue
+		}
+
+		switch aws.StringValue(v.Severity) {
+		case elasticbeanstalk.ValidationSeverityError:
+			diags = sdkdiag.AppendErrorf(diags, "invalid Elastic Beanstalk option setting (%s:%s): %s", aws.StringValue(v.Namespace), aws.StringValue(v.OptionName), aws.StringValue(v.Message))
+		case elasticbeanstalk.ValidationSeverityWarning:
+			log.Printf("[WARN] Elastic Beanstalk option setting (%s:%s): %s", aws.StringValue(v.Namespace), aws.StringValue(v.OptionName), aws.StringValue(v.Message))
+		}
+	}
+
+	return diags
+}
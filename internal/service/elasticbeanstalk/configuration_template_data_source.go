@@ -0,0 +1,164 @@
+package elasticbeanstalk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceConfigurationTemplate() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConfigurationTemplateRead,
+
+		Schema: map[string]*schema.Schema{
+			"application": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"application", "arn"},
+			},
+			"arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"application", "arn"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"environment_name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				RequiredWith:  []string{"application"},
+				ConflictsWith: []string{"arn", "name"},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				RequiredWith:  []string{"application"},
+				ConflictsWith: []string{"arn", "environment_name"},
+			},
+			"platform_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"setting": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     settingSchema(),
+				Set:      optionSettingValueHash,
+			},
+			"solution_stack_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceConfigurationTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkConn()
+
+	applicationName := d.Get("application").(string)
+	templateName := d.Get("name").(string)
+
+	if v, ok := d.GetOk("arn"); ok {
+		var err error
+		applicationName, templateName, err = configurationTemplateParseARN(v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	var settings *elasticbeanstalk.ConfigurationSettingsDescription
+
+	if v, ok := d.GetOk("environment_name"); ok {
+		environment, err := findEnvironmentByTwoPartKey(ctx, conn, applicationName, v.(string))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Elastic Beanstalk Environment", err))
+		}
+
+		settings, err = findConfigurationSettingsByTwoPartKey(ctx, conn, applicationName, aws.StringValue(environment.EnvironmentName))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Elastic Beanstalk Configuration Template", err))
+		}
+
+		d.SetId(aws.StringValue(environment.EnvironmentId))
+		d.Set("arn", nil)
+		d.Set("environment_id", environment.EnvironmentId)
+	} else {
+		if templateName == "" {
+			return sdkdiag.AppendErrorf(diags, "one of `name` or `environment_name` must be specified with `application`")
+		}
+
+		var err error
+		settings, err = FindConfigurationSettingsByTwoPartKey(ctx, conn, applicationName, templateName)
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Elastic Beanstalk Configuration Template", err))
+		}
+
+		d.SetId(templateName)
+		d.Set("arn", configurationTemplateARN(meta.(*conns.AWSClient), aws.StringValue(settings.ApplicationName), templateName))
+		d.Set("environment_id", nil)
+	}
+
+	d.Set("application", settings.ApplicationName)
+	d.Set("description", settings.Description)
+	d.Set("name", settings.TemplateName)
+	d.Set("platform_arn", settings.PlatformArn)
+	if err := d.Set("setting", flattenConfigurationOptionSettings(settings.OptionSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting setting: %s", err)
+	}
+	d.Set("solution_stack_name", settings.SolutionStackName)
+
+	return diags
+}
+
+// configurationTemplateParseARN returns the application and template names from a configuration template ARN.
+// The template name may itself contain a slash, so only the first slash after the resource type separates the names.
+func configurationTemplateParseARN(s string) (string, string, error) {
+	const (
+		resourceTypePrefix = "configurationtemplate/"
+		separator          = "/"
+	)
+
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", "", err
+	}
+
+	if v.Service != elasticbeanstalk.ServiceName || !strings.HasPrefix(v.Resource, resourceTypePrefix) {
+		return "", "", fmt.Errorf("%q is not an Elastic Beanstalk Configuration Template ARN", s)
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(v.Resource, resourceTypePrefix), separator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for Elastic Beanstalk Configuration Template ARN (%s), expected %sAPPLICATION%sTEMPLATE_NAME resource", s, resourceTypePrefix, separator)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,261 @@
+package elasticbeanstalk_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfelasticbeanstalk "github.com/hashicorp/terraform-provider-aws/internal/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestConfigurationTemplateParseARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn                     string
+		expectedApplicationName string
+		expectedTemplateName    string
+		expectError             bool
+	}{
+		"empty": {
+			expectError: true,
+		},
+		"not an ARN": {
+			arn:         "tf-acc-test-app/tf-acc-test-template",
+			expectError: true,
+		},
+		"other service": {
+			arn:         "arn:aws:s3:::configurationtemplate/tf-acc-test-app/tf-acc-test-template", //lintignore:AWSAT005
+			expectError: true,
+		},
+		"other resource type": {
+			arn:         "arn:aws:elasticbeanstalk:us-west-2:123456789012:application/tf-acc-test-app", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"missing template name": {
+			arn:         "arn:aws:elasticbeanstalk:us-west-2:123456789012:configurationtemplate/tf-acc-test-app", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"valid": {
+			arn:                     "arn:aws:elasticbeanstalk:us-west-2:123456789012:configurationtemplate/tf-acc-test-app/tf-acc-test-template", //lintignore:AWSAT003,AWSAT005
+			expectedApplicationName: "tf-acc-test-app",
+			expectedTemplateName:    "tf-acc-test-template",
+		},
+		"template name with slash": {
+			arn:                     "arn:aws:elasticbeanstalk:us-west-2:123456789012:configurationtemplate/tf-acc-test-app/tf-acc/test-template", //lintignore:AWSAT003,AWSAT005
+			expectedApplicationName: "tf-acc-test-app",
+			expectedTemplateName:    "tf-acc/test-template",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			applicationName, templateName, err := tfelasticbeanstalk.ConfigurationTemplateParseARN(testCase.arn)
+
+			if got, expected := err != nil, testCase.expectError; got != expected {
+				t.Fatalf("got error %v, expected error: %t", err, expected)
+			}
+
+			if applicationName != testCase.expectedApplicationName {
+				t.Errorf("got application name %s, expected %s", applicationName, testCase.expectedApplicationName)
+			}
+
+			if templateName != testCase.expectedTemplateName {
+				t.Errorf("got template name %s, expected %s", templateName, testCase.expectedTemplateName)
+			}
+		})
+	}
+}
+
+func TestFindEnvironmentByTwoPartKey(t *testing.T) {
+	t.Parallel()
+
+	const (
+		applicationName = "tf-acc-test-app"
+		environmentID   = "e-abcdef1234"
+		environmentName = "tf-acc-test-env"
+	)
+
+	testCases := map[string]struct {
+		environments   []*elasticbeanstalk.EnvironmentDescription
+		expectNotFound bool
+	}{
+		"ready": {
+			environments: []*elasticbeanstalk.EnvironmentDescription{{
+				EnvironmentId:   aws.String(environmentID),
+				EnvironmentName: aws.String(environmentName),
+				Status:          aws.String(elasticbeanstalk.EnvironmentStatusReady),
+			}},
+		},
+		"terminated": {
+			environments: []*elasticbeanstalk.EnvironmentDescription{{
+				EnvironmentId:   aws.String(environmentID),
+				EnvironmentName: aws.String(environmentName),
+				Status:          aws.String(elasticbeanstalk.EnvironmentStatusTerminated),
+			}},
+			expectNotFound: true,
+		},
+		"empty result": {
+			expectNotFound: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var input *elasticbeanstalk.DescribeEnvironmentsInput
+			conn := testStubbedConn(t, func(r *request.Request) {
+				input = r.Params.(*elasticbeanstalk.DescribeEnvironmentsInput)
+				r.Data.(*elasticbeanstalk.EnvironmentDescriptionsMessage).Environments = testCase.environments
+			})
+
+			got, err := tfelasticbeanstalk.FindEnvironmentByTwoPartKey(acctest.Context(t), conn, applicationName, environmentName)
+
+			if got, want := aws.StringValue(input.ApplicationName), applicationName; got != want {
+				t.Errorf("got request application name %q, expected %q", got, want)
+			}
+
+			if got, want := aws.StringValueSlice(input.EnvironmentNames), []string{environmentName}; len(got) != 1 || got[0] != want[0] {
+				t.Errorf("got request environment names %v, expected %v", got, want)
+			}
+
+			if testCase.expectNotFound {
+				if !tfresource.NotFound(err) {
+					t.Fatalf("expected NotFound error, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := aws.StringValue(got.EnvironmentId); got != environmentID {
+				t.Errorf("got environment ID %q, expected %q", got, environmentID)
+			}
+		})
+	}
+}
+
+func TestAccElasticBeanstalkConfigurationTemplateDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_elastic_beanstalk_configuration_template.test"
+	resourceName := "aws_elastic_beanstalk_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationTemplateDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "application", resourceName, "application"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "setting.*", map[string]string{
+						"namespace": "aws:ec2:vpc",
+						"name":      "VPCId",
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, "solution_stack_name", resourceName, "solution_stack_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkConfigurationTemplateDataSource_arn(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_elastic_beanstalk_configuration_template.test"
+	resourceName := "aws_elastic_beanstalk_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationTemplateDataSourceConfig_arn(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "application", resourceName, "application"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "solution_stack_name", resourceName, "solution_stack_name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkConfigurationTemplateDataSource_environmentName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_elastic_beanstalk_configuration_template.test"
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationTemplateDataSourceConfig_environmentName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "application", resourceName, "application"),
+					resource.TestCheckResourceAttr(dataSourceName, "arn", ""),
+					resource.TestCheckResourceAttrPair(dataSourceName, "environment_id", resourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "setting.*", map[string]string{
+						"namespace": "aws:ec2:vpc",
+						"name":      "VPCId",
+					}),
+					resource.TestCheckResourceAttrPair(dataSourceName, "solution_stack_name", resourceName, "solution_stack_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigurationTemplateDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccConfigurationTemplateConfig_vpc(rName), `
+data "aws_elastic_beanstalk_configuration_template" "test" {
+  application = aws_elastic_beanstalk_configuration_template.test.application
+  name        = aws_elastic_beanstalk_configuration_template.test.name
+}
+`)
+}
+
+func testAccConfigurationTemplateDataSourceConfig_arn(rName string) string {
+	return acctest.ConfigCompose(testAccConfigurationTemplateConfig_basic(rName), `
+data "aws_elastic_beanstalk_configuration_template" "test" {
+  arn = aws_elastic_beanstalk_configuration_template.test.arn
+}
+`)
+}
+
+func testAccConfigurationTemplateDataSourceConfig_environmentName(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName), `
+data "aws_elastic_beanstalk_configuration_template" "test" {
+  application      = aws_elastic_beanstalk_environment.test.application
+  environment_name = aws_elastic_beanstalk_environment.test.name
+}
+`)
+}
@@ -2,12 +2,25 @@ package elasticbeanstalk_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -15,6 +28,567 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestFindConfigurationSettingsByTwoPartKey_notFoundLastRequest(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	expectedRequest := &elasticbeanstalk.DescribeConfigurationSettingsInput{
+		ApplicationName: aws.String("tf-acc-test-app"),
+		TemplateName:    aws.String("tf-acc-test-template"),
+	}
+
+	testCases := map[string]struct {
+		send func(r *request.Request)
+	}{
+		"missing template": {
+			send: func(r *request.Request) {
+				r.Error = awserr.New("InvalidParameterValue", "No Configuration Template named 'tf-acc-test-app/tf-acc-test-template' found.", nil)
+			},
+		},
+		"empty result": {
+			send: func(r *request.Request) {
+				r.Data.(*elasticbeanstalk.DescribeConfigurationSettingsOutput).ConfigurationSettings = []*elasticbeanstalk.ConfigurationSettingsDescription{}
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := testStubbedConn(t, testCase.send)
+
+			_, err := tfelasticbeanstalk.FindConfigurationSettingsByTwoPartKey(ctx, conn, aws.StringValue(expectedRequest.ApplicationName), aws.StringValue(expectedRequest.TemplateName))
+
+			if !tfresource.NotFound(err) {
+				t.Fatalf("expected NotFound error, got %v", err)
+			}
+
+			var nfe *resource.NotFoundError
+			if !errors.As(err, &nfe) {
+				t.Fatalf("expected *resource.NotFoundError, got %T", err)
+			}
+
+			if !reflect.DeepEqual(nfe.LastRequest, expectedRequest) {
+				t.Errorf("got LastRequest %v, expected %v", nfe.LastRequest, expectedRequest)
+			}
+		})
+	}
+}
+
+func TestFindConfigurationSettingsByTwoPartKey_throttled(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	for _, errCode := range []string{"Throttling", "TooManyRequestsException"} {
+		errCode := errCode
+
+		t.Run(errCode, func(t *testing.T) {
+			t.Parallel()
+
+			var requests int
+			conn := testStubbedConn(t, func(r *request.Request) {
+				requests++
+
+				if requests == 1 {
+					r.Error = awserr.New(errCode, "Rate exceeded", nil)
+					return
+				}
+
+				r.Data.(*elasticbeanstalk.DescribeConfigurationSettingsOutput).ConfigurationSettings = []*elasticbeanstalk.ConfigurationSettingsDescription{{
+					ApplicationName: aws.String("tf-acc-test-app"),
+					TemplateName:    aws.String("tf-acc-test-template"),
+				}}
+			})
+
+			output, err := tfelasticbeanstalk.FindConfigurationSettingsByTwoPartKey(ctx, conn, "tf-acc-test-app", "tf-acc-test-template")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := aws.StringValue(output.TemplateName), "tf-acc-test-template"; got != expected {
+				t.Errorf("got template %s, expected %s", got, expected)
+			}
+
+			if got, expected := requests, 2; got != expected {
+				t.Errorf("got %d requests, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+func TestFindConfigurationSettingsByTwoPartKey_customEndpoint(t *testing.T) {
+	t.Parallel()
+
+	const region = "us-gov-west-1" //lintignore:AWSAT003
+
+	ctx := acctest.Context(t)
+
+	var action, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		action = r.PostForm.Get("Action")
+		authorization = r.Header.Get("Authorization")
+
+		fmt.Fprint(w, `<DescribeConfigurationSettingsResponse xmlns="http://elasticbeanstalk.amazonaws.com/docs/2010-12-01/">
+  <DescribeConfigurationSettingsResult>
+    <ConfigurationSettings>
+      <member>
+        <ApplicationName>tf-acc-test-app</ApplicationName>
+        <TemplateName>tf-acc-test-template</TemplateName>
+      </member>
+    </ConfigurationSettings>
+  </DescribeConfigurationSettingsResult>
+  <ResponseMetadata>
+    <RequestId>00000000-0000-0000-0000-000000000000</RequestId>
+  </ResponseMetadata>
+</DescribeConfigurationSettingsResponse>`)
+	}))
+	defer server.Close()
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials("AKIAEXAMPLE", "secret", ""),
+		Region:      aws.String(region),
+	})
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	// The provider configures service endpoints the same way (see conns.Config).
+	conn := elasticbeanstalk.New(sess.Copy(&aws.Config{Endpoint: aws.String(server.URL)}))
+
+	settings, err := tfelasticbeanstalk.FindConfigurationSettingsByTwoPartKey(ctx, conn, "tf-acc-test-app", "tf-acc-test-template")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := aws.StringValue(settings.TemplateName), "tf-acc-test-template"; got != expected {
+		t.Errorf("got TemplateName %q, expected %q", got, expected)
+	}
+
+	if expected := "DescribeConfigurationSettings"; action != expected {
+		t.Errorf("got Action %q at custom endpoint, expected %q", action, expected)
+	}
+
+	if expected := fmt.Sprintf("/%s/%s/aws4_request", region, elasticbeanstalk.EndpointsID); !strings.Contains(authorization, expected) {
+		t.Errorf("got Authorization %q, expected credential scope containing %q", authorization, expected)
+	}
+}
+
+func TestFindConfigurationTemplatePlatformByTwoPartKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+	const platformARN = "arn:aws:elasticbeanstalk:us-west-2::platform/Python 3.8 running on 64bit Amazon Linux 2/3.3.0" //lintignore:AWSAT003,AWSAT005
+
+	var requestedPlatformARN string
+	conn := testStubbedConn(t, func(r *request.Request) {
+		switch output := r.Data.(type) {
+		case *elasticbeanstalk.DescribeConfigurationOptionsOutput:
+			output.PlatformArn = aws.String(platformARN)
+			output.SolutionStackName = aws.String("64bit Amazon Linux 2 v3.3.0 running Python 3.8")
+		case *elasticbeanstalk.DescribePlatformVersionOutput:
+			requestedPlatformARN = aws.StringValue(r.Params.(*elasticbeanstalk.DescribePlatformVersionInput).PlatformArn)
+			output.PlatformDescription = &elasticbeanstalk.PlatformDescription{
+				OperatingSystemName:    aws.String("Amazon Linux"),
+				OperatingSystemVersion: aws.String("2"),
+				PlatformArn:            aws.String(platformARN),
+				PlatformBranchName:     aws.String("Python 3.8 running on 64bit Amazon Linux 2"),
+				PlatformLifecycleState: aws.String("Recommended"),
+				ProgrammingLanguages: []*elasticbeanstalk.PlatformProgrammingLanguage{{
+					Name:    aws.String("Python"),
+					Version: aws.String("3.8.5"),
+				}},
+			}
+		}
+	})
+
+	platform, err := tfelasticbeanstalk.FindConfigurationTemplatePlatformByTwoPartKey(ctx, conn, "tf-acc-test-app", "tf-acc-test-template")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if requestedPlatformARN != platformARN {
+		t.Errorf("got platform version %q, expected %q", requestedPlatformARN, platformARN)
+	}
+
+	got := tfelasticbeanstalk.FlattenPlatformDescription(platform)
+	expected := []interface{}{
+		map[string]interface{}{
+			"operating_system_name":    "Amazon Linux",
+			"operating_system_version": "2",
+			"platform_arn":             platformARN,
+			"platform_branch_name":     "Python 3.8 running on 64bit Amazon Linux 2",
+			"platform_lifecycle_state": "Recommended",
+			"programming_language": []interface{}{
+				map[string]interface{}{
+					"name":    "Python",
+					"version": "3.8.5",
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestConfigurationTemplateImportWarning(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		settings *elasticbeanstalk.ConfigurationSettingsDescription
+		expected string
+	}{
+		"empty settings": {
+			settings: &elasticbeanstalk.ConfigurationSettingsDescription{
+				TemplateName: aws.String("test"),
+			},
+			expected: "Elastic Beanstalk Configuration Template (test) has no option settings and may be an empty shell",
+		},
+		"unparseable settings": {
+			settings: &elasticbeanstalk.ConfigurationSettingsDescription{
+				OptionSettings: []*elasticbeanstalk.ConfigurationOptionSetting{
+					{Namespace: aws.String("aws:autoscaling:asg"), OptionName: aws.String("MinSize"), Value: aws.String("1")},
+					{Namespace: aws.String("aws:autoscaling:asg"), Value: aws.String("2")},
+				},
+				TemplateName: aws.String("test"),
+			},
+			expected: "Elastic Beanstalk Configuration Template (test) has 1 option settings without a namespace or name",
+		},
+		"valid settings": {
+			settings: &elasticbeanstalk.ConfigurationSettingsDescription{
+				OptionSettings: []*elasticbeanstalk.ConfigurationOptionSetting{
+					{Namespace: aws.String("aws:autoscaling:asg"), OptionName: aws.String("MinSize"), Value: aws.String("1")},
+				},
+				TemplateName: aws.String("test"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := tfelasticbeanstalk.ConfigurationTemplateImportWarning(testCase.settings), testCase.expected; got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfiguredOptionSettings(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []*elasticbeanstalk.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String("aws:autoscaling:launchconfiguration"),
+			OptionName: aws.String("InstanceType"),
+			Value:      aws.String("t3.micro"),
+		},
+		{
+			Namespace:  aws.String("aws:ec2:vpc"),
+			OptionName: aws.String("Subnets"),
+			Value:      aws.String("subnet-2,subnet-1"),
+		},
+		{
+			Namespace:  aws.String("aws:elasticbeanstalk:command"),
+			OptionName: aws.String("Timeout"),
+			Value:      aws.String("600"),
+		},
+	}
+	configured := schema.NewSet(schema.HashResource(&schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"namespace": {Type: schema.TypeString},
+			"name":      {Type: schema.TypeString},
+			"value":     {Type: schema.TypeString},
+		},
+	}), []interface{}{
+		map[string]interface{}{
+			"namespace": "aws:autoscaling:launchconfiguration",
+			"name":      "InstanceType",
+			"value":     "m1.small",
+		},
+		map[string]interface{}{
+			"namespace": "aws:ec2:vpc",
+			"name":      "Subnets",
+			"value":     "subnet-1,subnet-2",
+		},
+	})
+
+	var got []string
+	for _, v := range tfelasticbeanstalk.ConfiguredOptionSettings(apiObjects, configured).List() {
+		m := v.(map[string]interface{})
+		got = append(got, fmt.Sprintf("%s:%s=%s", m["namespace"], m["name"], m["value"]))
+	}
+	sort.Strings(got)
+
+	expected := []string{
+		"aws:autoscaling:launchconfiguration:InstanceType=t3.micro",
+		"aws:ec2:vpc:Subnets=subnet-1,subnet-2",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestSuppressEquivalentSolutionStackNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		old      string
+		new      string
+		expected bool
+	}{
+		"identical": {
+			old:      "64bit Amazon Linux 2 v3.4.0 running Go 1",
+			new:      "64bit Amazon Linux 2 v3.4.0 running Go 1",
+			expected: true,
+		},
+		"whitespace": {
+			old:      "64bit Amazon Linux 2 v3.4.0 running Go 1",
+			new:      " 64bit  Amazon Linux 2 v3.4.0 running Go 1 ",
+			expected: true,
+		},
+		"server-normalized version": {
+			old:      "64bit Amazon Linux 2 v3.4.0 running Go 1",
+			new:      "64bit Amazon Linux 2 running Go 1",
+			expected: true,
+		},
+		"trailing version": {
+			old:      "64bit Windows Server 2019 running IIS 10.0",
+			new:      "64bit Windows Server 2019 v2.10.1 running IIS 10.0",
+			expected: true,
+		},
+		"different versions": {
+			old:      "64bit Amazon Linux 2 v3.4.0 running Go 1",
+			new:      "64bit Amazon Linux 2 v3.5.0 running Go 1",
+			expected: false,
+		},
+		"different stacks": {
+			old:      "64bit Amazon Linux 2 v3.4.0 running Go 1",
+			new:      "64bit Amazon Linux 2 v3.4.0 running Python 3.8",
+			expected: false,
+		},
+		"new resource": {
+			old:      "",
+			new:      "64bit Amazon Linux 2 running Go 1",
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfelasticbeanstalk.SuppressEquivalentSolutionStackNames("solution_stack_name", testCase.old, testCase.new, nil)
+
+			if got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestConfigurationTemplateValidationDiags(t *testing.T) {
+	t.Parallel()
+
+	messages := []*elasticbeanstalk.ValidationMessage{
+		{
+			Message:    aws.String("Invalid option specification (Namespace: 'aws:invalid:namespace', OptionName: 'InstanceType'): Unknown configuration setting."),
+			Namespace:  aws.String("aws:invalid:namespace"),
+			OptionName: aws.String("InstanceType"),
+			Severity:   aws.String(elasticbeanstalk.ValidationSeverityError),
+		},
+		{
+			Message:    aws.String("Option will be ignored."),
+			Namespace:  aws.String("aws:elasticbeanstalk:application:environment"),
+			OptionName: aws.String("FOO"),
+			Severity:   aws.String(elasticbeanstalk.ValidationSeverityWarning),
+		},
+		nil,
+	}
+
+	got := tfelasticbeanstalk.ConfigurationTemplateValidationDiags(messages)
+
+	if len(got) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(got))
+	}
+
+	if !got.HasError() {
+		t.Errorf("expected an error diagnostic, got %v", got)
+	}
+
+	if summary := got[0].Summary; !strings.Contains(summary, "aws:invalid:namespace:InstanceType") {
+		t.Errorf("expected summary to include the invalid setting, got %q", summary)
+	}
+}
+
+func TestOptionSettingsJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		apiObjects []*elasticbeanstalk.ConfigurationOptionSetting
+		expected   string
+	}{
+		"nil": {
+			expected: `[]`,
+		},
+		"sorted": {
+			apiObjects: []*elasticbeanstalk.ConfigurationOptionSetting{
+				{Namespace: aws.String("aws:ec2:vpc"), OptionName: aws.String("Subnets"), Value: aws.String("subnet-1,subnet-2")},
+				nil,
+				{Namespace: aws.String("aws:autoscaling:asg"), OptionName: aws.String("MinSize"), Value: aws.String("1")},
+				{Namespace: aws.String("aws:autoscaling:asg"), OptionName: aws.String("MaxSize"), Value: aws.String("2")},
+			},
+			expected: `[{"namespace":"aws:autoscaling:asg","name":"MaxSize","value":"2"},{"namespace":"aws:autoscaling:asg","name":"MinSize","value":"1"},{"namespace":"aws:ec2:vpc","name":"Subnets","value":"subnet-1,subnet-2"}]`,
+		},
+		"resource": {
+			apiObjects: []*elasticbeanstalk.ConfigurationOptionSetting{
+				{Namespace: aws.String("aws:autoscaling:scheduledaction"), OptionName: aws.String("MinSize"), ResourceName: aws.String("ScheduledAction02"), Value: aws.String("2")},
+				{Namespace: aws.String("aws:autoscaling:scheduledaction"), OptionName: aws.String("MinSize"), ResourceName: aws.String("ScheduledAction01"), Value: aws.String("1")},
+			},
+			expected: `[{"namespace":"aws:autoscaling:scheduledaction","name":"MinSize","resource":"ScheduledAction01","value":"1"},{"namespace":"aws:autoscaling:scheduledaction","name":"MinSize","resource":"ScheduledAction02","value":"2"}]`,
+		},
+		"escaped value": {
+			apiObjects: []*elasticbeanstalk.ConfigurationOptionSetting{
+				{Namespace: aws.String("aws:elasticbeanstalk:application:environment"), OptionName: aws.String("CONFIG"), Value: aws.String(`{"key": "value"}`)},
+			},
+			expected: `[{"namespace":"aws:elasticbeanstalk:application:environment","name":"CONFIG","value":"{\"key\": \"value\"}"}]`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := tfelasticbeanstalk.OptionSettingsJSON(testCase.apiObjects), testCase.expected; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestConfigurationTemplateOptionSettingsUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctx := acctest.Context(t)
+
+	setting := func(value string) map[string]interface{} {
+		return map[string]interface{}{
+			"namespace": "aws:ec2:vpc",
+			"name":      "Subnets",
+			"resource":  "",
+			"value":     value,
+		}
+	}
+
+	testCases := map[string]struct {
+		newValue           string
+		messages           []*elasticbeanstalk.ValidationMessage
+		expectedOperations []string
+		expectError        bool
+	}{
+		"reordered values": {
+			newValue: "subnet-2,subnet-1",
+		},
+		"changed values": {
+			newValue:           "subnet-1,subnet-3",
+			expectedOperations: []string{"ValidateConfigurationSettings", "UpdateConfigurationTemplate"},
+		},
+		"validation warning": {
+			newValue: "subnet-1,subnet-3",
+			messages: []*elasticbeanstalk.ValidationMessage{
+				{Namespace: aws.String("aws:ec2:vpc"), OptionName: aws.String("Subnets"), Severity: aws.String(elasticbeanstalk.ValidationSeverityWarning), Message: aws.String("test warning")},
+			},
+			expectedOperations: []string{"ValidateConfigurationSettings", "UpdateConfigurationTemplate"},
+		},
+		"validation error": {
+			newValue: "subnet-1,subnet-3",
+			messages: []*elasticbeanstalk.ValidationMessage{
+				{Namespace: aws.String("aws:ec2:vpc"), OptionName: aws.String("Subnets"), Severity: aws.String(elasticbeanstalk.ValidationSeverityError), Message: aws.String("test error")},
+			},
+			expectedOperations: []string{"ValidateConfigurationSettings"},
+			expectError:        true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := tfelasticbeanstalk.ResourceConfigurationTemplate()
+			d := r.TestResourceData()
+			d.SetId("tf-acc-test-template")
+			d.Set("application", "tf-acc-test-app")
+			d.Set("name", "tf-acc-test-template")
+			d.Set("setting", []interface{}{setting("subnet-1,subnet-2")})
+			state := d.State()
+
+			// Option settings are hashed with their comma-separated values sorted, so a configuration that only
+			// reorders the values keeps the setting's key and just changes its raw value.
+			var key string
+			for k := range state.Attributes {
+				if strings.HasPrefix(k, "setting.") && strings.HasSuffix(k, ".value") {
+					key = k
+				}
+			}
+
+			d, err := schema.InternalMap(r.Schema).Data(state, &terraform.InstanceDiff{
+				Attributes: map[string]*terraform.ResourceAttrDiff{
+					key: {Old: "subnet-1,subnet-2", New: testCase.newValue},
+				},
+			})
+			if err != nil {
+				t.Fatalf("building resource data: %s", err)
+			}
+
+			if !d.HasChange("setting") {
+				t.Fatal("expected setting to have changed")
+			}
+
+			var operations []string
+			conn := testStubbedConn(t, func(r *request.Request) {
+				operations = append(operations, r.Operation.Name)
+
+				if output, ok := r.Data.(*elasticbeanstalk.ValidateConfigurationSettingsOutput); ok {
+					output.Messages = testCase.messages
+				}
+			})
+
+			err = tfelasticbeanstalk.ResourceConfigurationTemplateOptionSettingsUpdate(ctx, conn, d)
+
+			if got, expected := err != nil, testCase.expectError; got != expected {
+				t.Errorf("got error %v, expected error: %t", err, expected)
+			}
+
+			if !reflect.DeepEqual(operations, testCase.expectedOperations) {
+				t.Errorf("got operations %v, expected %v", operations, testCase.expectedOperations)
+			}
+		})
+	}
+}
+
 func TestAccElasticBeanstalkConfigurationTemplate_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var config elasticbeanstalk.ConfigurationSettingsDescription
@@ -31,8 +605,46 @@ func TestAccElasticBeanstalkConfigurationTemplate_basic(t *testing.T) {
 				Config: testAccConfigurationTemplateConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckConfigurationTemplateExists(ctx, resourceName, &config),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "elasticbeanstalk", regexp.MustCompile(fmt.Sprintf(`configurationtemplate/%[1]s/%[1]s`, rName))),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccConfigurationTemplateImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkConfigurationTemplate_emptyDescription(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config elasticbeanstalk.ConfigurationSettingsDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationTemplateExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
 				),
 			},
+			{
+				Config:   testAccConfigurationTemplateConfig_basic(rName),
+				PlanOnly: true,
+			},
+			{
+				Config:   testAccConfigurationTemplateConfig_emptyDescription(rName),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -61,6 +673,48 @@ func TestAccElasticBeanstalkConfigurationTemplate_disappears(t *testing.T) {
 	})
 }
 
+func TestAccElasticBeanstalkConfigurationTemplate_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config elasticbeanstalk.ConfigurationSettingsDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationTemplateConfig_tags2(rName, "key1", "value1", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationTemplateExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConfigurationTemplateConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationTemplateExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccConfigurationTemplateConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationTemplateExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccElasticBeanstalkConfigurationTemplate_vpc(t *testing.T) {
 	ctx := acctest.Context(t)
 	var config elasticbeanstalk.ConfigurationSettingsDescription
@@ -95,6 +749,116 @@ func TestAccElasticBeanstalkConfigurationTemplate_settings(t *testing.T) {
 		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
 		CheckDestroy:             testAccCheckConfigurationTemplateDestroy(ctx),
 		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationTemplateConfig_setting(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationTemplateExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"value": "m1.small",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "settings_map.aws:autoscaling:launchconfiguration:InstanceType", "m1.small"),
+				),
+			},
+			{
+				Config: testAccConfigurationTemplateConfig_settingUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationTemplateExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "setting.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "setting.*", map[string]string{
+						"namespace": "aws:autoscaling:launchconfiguration",
+						"name":      "InstanceType",
+						"value":     "t3.micro",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkConfigurationTemplate_fetchPlatformDetails(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config elasticbeanstalk.ConfigurationSettingsDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationTemplateConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationTemplateExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "fetch_platform_details", "false"),
+					resource.TestCheckResourceAttr(resourceName, "platform_details.#", "0"),
+				),
+			},
+			{
+				Config: testAccConfigurationTemplateConfig_fetchPlatformDetails(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationTemplateExists(ctx, resourceName, &config),
+					resource.TestCheckResourceAttr(resourceName, "fetch_platform_details", "true"),
+					resource.TestCheckResourceAttr(resourceName, "platform_details.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "platform_details.0.operating_system_name", "Amazon Linux"),
+					resource.TestCheckResourceAttrSet(resourceName, "platform_details.0.operating_system_version"),
+					acctest.MatchResourceAttrRegionalARNNoAccount(resourceName, "platform_details.0.platform_arn", "elasticbeanstalk", regexp.MustCompile(`platform/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "platform_details.0.platform_branch_name"),
+					resource.TestCheckResourceAttr(resourceName, "platform_details.0.programming_language.0.name", "Python"),
+					resource.TestCheckResourceAttrSet(resourceName, "platform_details.0.programming_language.0.version"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccConfigurationTemplateImportStateIDFunc(resourceName),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fetch_platform_details", "platform_details"},
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkConfigurationTemplate_invalidSetting(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfigurationTemplateConfig_invalidSetting(rName),
+				ExpectError: regexp.MustCompile(`invalid Elastic Beanstalk option setting \(aws:invalid:namespace:InstanceType\)`),
+			},
+		},
+	})
+}
+
+func TestAccElasticBeanstalkConfigurationTemplate_settingDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var config elasticbeanstalk.ConfigurationSettingsDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_configuration_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, elasticbeanstalk.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfigurationTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigurationTemplateConfig_setting(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckConfigurationTemplateExists(ctx, resourceName, &config),
+					testAccCheckConfigurationTemplateUpdateSetting(ctx, &config, "aws:autoscaling:launchconfiguration", "InstanceType", "t3.micro"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
 			{
 				Config: testAccConfigurationTemplateConfig_setting(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -109,6 +873,23 @@ func TestAccElasticBeanstalkConfigurationTemplate_settings(t *testing.T) {
 	})
 }
 
+// testStubbedConn returns an Elastic Beanstalk connection that makes no requests.
+// Each request is passed to send instead, which sets its output or error.
+func testStubbedConn(t *testing.T, send func(r *request.Request)) *elasticbeanstalk.ElasticBeanstalk {
+	t.Helper()
+
+	sess, err := session.NewSession(&aws.Config{Region: aws.String("us-west-2")}) //lintignore:AWSAT003
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := elasticbeanstalk.New(sess)
+	conn.Handlers.Clear()
+	conn.Handlers.Send.PushBack(send)
+
+	return conn
+}
+
 func testAccCheckConfigurationTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticBeanstalkConn()
@@ -160,6 +941,37 @@ func testAccCheckConfigurationTemplateExists(ctx context.Context, n string, v *e
 	}
 }
 
+func testAccCheckConfigurationTemplateUpdateSetting(ctx context.Context, v *elasticbeanstalk.ConfigurationSettingsDescription, namespace, name, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElasticBeanstalkConn()
+
+		_, err := conn.UpdateConfigurationTemplateWithContext(ctx, &elasticbeanstalk.UpdateConfigurationTemplateInput{
+			ApplicationName: v.ApplicationName,
+			OptionSettings: []*elasticbeanstalk.ConfigurationOptionSetting{
+				{
+					Namespace:  aws.String(namespace),
+					OptionName: aws.String(name),
+					Value:      aws.String(value),
+				},
+			},
+			TemplateName: v.TemplateName,
+		})
+
+		return err
+	}
+}
+
+func testAccConfigurationTemplateImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["application"], rs.Primary.Attributes["name"]), nil
+	}
+}
+
 func testAccConfigurationTemplateConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_elastic_beanstalk_application" "test" {
@@ -175,6 +987,38 @@ resource "aws_elastic_beanstalk_configuration_template" "test" {
 `, rName)
 }
 
+func testAccConfigurationTemplateConfig_emptyDescription(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "test" {
+  name        = %[1]q
+  description = "testing"
+}
+
+resource "aws_elastic_beanstalk_configuration_template" "test" {
+  name                = %[1]q
+  application         = aws_elastic_beanstalk_application.test.name
+  description         = ""
+  solution_stack_name = "64bit Amazon Linux running Python"
+}
+`, rName)
+}
+
+func testAccConfigurationTemplateConfig_fetchPlatformDetails(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "test" {
+  name        = %[1]q
+  description = "testing"
+}
+
+resource "aws_elastic_beanstalk_configuration_template" "test" {
+  name                   = %[1]q
+  application            = aws_elastic_beanstalk_application.test.name
+  solution_stack_name    = "64bit Amazon Linux running Python"
+  fetch_platform_details = true
+}
+`, rName)
+}
+
 func testAccConfigurationTemplateConfig_vpc(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
 resource "aws_elastic_beanstalk_application" "test" {
@@ -224,3 +1068,86 @@ resource "aws_elastic_beanstalk_configuration_template" "test" {
 }
 `, rName)
 }
+
+func testAccConfigurationTemplateConfig_invalidSetting(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "test" {
+  name        = %[1]q
+  description = "testing"
+}
+
+resource "aws_elastic_beanstalk_configuration_template" "test" {
+  name        = %[1]q
+  application = aws_elastic_beanstalk_application.test.name
+
+  solution_stack_name = "64bit Amazon Linux running Python"
+
+  setting {
+    namespace = "aws:invalid:namespace"
+    name      = "InstanceType"
+    value     = "m1.small"
+  }
+}
+`, rName)
+}
+
+func testAccConfigurationTemplateConfig_settingUpdated(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "test" {
+  name        = %[1]q
+  description = "testing"
+}
+
+resource "aws_elastic_beanstalk_configuration_template" "test" {
+  name        = %[1]q
+  application = aws_elastic_beanstalk_application.test.name
+
+  solution_stack_name = "64bit Amazon Linux running Python"
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "InstanceType"
+    value     = "t3.micro"
+  }
+}
+`, rName)
+}
+
+func testAccConfigurationTemplateConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "test" {
+  name        = %[1]q
+  description = "testing"
+}
+
+resource "aws_elastic_beanstalk_configuration_template" "test" {
+  name                = %[1]q
+  application         = aws_elastic_beanstalk_application.test.name
+  solution_stack_name = "64bit Amazon Linux running Python"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccConfigurationTemplateConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_elastic_beanstalk_application" "test" {
+  name        = %[1]q
+  description = "testing"
+}
+
+resource "aws_elastic_beanstalk_configuration_template" "test" {
+  name                = %[1]q
+  application         = aws_elastic_beanstalk_application.test.name
+  solution_stack_name = "64bit Amazon Linux running Python"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -15,6 +15,7 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -22,6 +23,7 @@ import ( // nosemgrep:ci.aws-sdk-go-multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdktypes"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -52,6 +54,51 @@ func settingSchema() *schema.Resource {
 	}
 }
 
+// knownOptionSettingValues are the valid values of well-known option settings, keyed by namespace and option name.
+// The values of other option settings are not validated.
+var knownOptionSettingValues = map[string][]string{
+	"aws:ec2:vpc:ELBScheme":                                  {"public", "internal"},
+	"aws:elasticbeanstalk:command:DeploymentPolicy":          {"AllAtOnce", "Rolling", "RollingWithAdditionalBatch", "Immutable", "TrafficSplitting"},
+	"aws:elasticbeanstalk:environment:EnvironmentType":       {"SingleInstance", "LoadBalanced"},
+	"aws:elasticbeanstalk:environment:LoadBalancerType":      {"classic", "application", "network"},
+	"aws:elasticbeanstalk:healthreporting:system:SystemType": {"basic", "enhanced"},
+}
+
+// validateOptionSettingValues returns an error for each setting whose value is not valid for a well-known option.
+// Empty values, which include values that are not yet known, are not validated.
+func validateOptionSettingValues(settings []interface{}) error {
+	var errs *multierror.Error
+
+	for _, v := range settings {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		namespace, _ := tfMap["namespace"].(string)
+		name, _ := tfMap["name"].(string)
+		value, _ := tfMap["value"].(string)
+
+		validValues, ok := knownOptionSettingValues[namespace+":"+name]
+
+		if !ok || value == "" {
+			continue
+		}
+
+		if !slices.Contains(validValues, value) {
+			errs = multierror.Append(errs, fmt.Errorf("invalid value for Elastic Beanstalk option setting (%s:%s): %q, expected one of %s", namespace, name, value, strings.Join(validValues, ", ")))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// customizeDiffValidateOptionSettingValues rejects invalid values of well-known option settings at plan time.
+func customizeDiffValidateOptionSettingValues(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	return validateOptionSettingValues(diff.Get("setting").(*schema.Set).List())
+}
+
 const (
 	environmentTierWebServer = "WebServer"
 	environmentTierWorker    = "Worker"
@@ -85,7 +132,10 @@ func ResourceEnvironment() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			customizeDiffValidateOptionSettingValues,
+			verify.SetTagsDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  EnvironmentMigrateState,
@@ -128,6 +178,11 @@ func ResourceEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ignore_setting_namespaces": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"instances": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -267,13 +322,15 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	opTime := time.Now()
-	output, err := conn.CreateEnvironmentWithContext(ctx, input)
+	outputRaw, err := newRetryBudgetFromEnv().retryWhenThrottled(ctx, func() (interface{}, error) {
+		return conn.CreateEnvironmentWithContext(ctx, input)
+	})
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Elastic Beanstalk Environment (%s): %s", name, err)
 	}
 
-	d.SetId(aws.StringValue(output.EnvironmentId))
+	d.SetId(aws.StringValue(outputRaw.(*elasticbeanstalk.EnvironmentDescription).EnvironmentId))
 
 	waitForReadyTimeOut, _, err := sdktypes.Duration(d.Get("wait_for_ready_timeout").(string)).Value()
 
@@ -419,6 +476,10 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	updatedSettings := schema.NewSet(optionSettingValueHash, updatedSettingsKeySet.List())
 
+	if v, ok := d.GetOk("ignore_setting_namespaces"); ok && v.(*schema.Set).Len() > 0 {
+		updatedSettings = ignoreOptionSettingNamespaces(updatedSettings, settings, flex.ExpandStringValueSet(v.(*schema.Set)))
+	}
+
 	if err := d.Set("all_settings", allSettings.List()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting all_settings: %s", err)
 	}
@@ -463,7 +524,7 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		pollInterval = 0
 	}
 
-	if d.HasChangesExcept("tags", "tags_all", "wait_for_ready_timeout", "poll_interval") {
+	if d.HasChangesExcept("tags", "tags_all", "ignore_setting_namespaces", "wait_for_ready_timeout", "poll_interval") {
 		input := elasticbeanstalk.UpdateEnvironmentInput{
 			EnvironmentId: aws.String(d.Id()),
 		}
@@ -481,51 +542,7 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 				n = &schema.Set{F: optionSettingValueHash}
 			}
 
-			os := o.(*schema.Set)
-			ns := n.(*schema.Set)
-
-			rm := extractOptionSettings(os.Difference(ns))
-			add := extractOptionSettings(ns.Difference(os))
-
-			// Additions and removals of options are done in a single API call, so we
-			// can't do our normal "remove these" and then later "add these", re-adding
-			// any updated settings.
-			// Because of this, we need to exclude any settings in the "removable"
-			// settings that are also found in the "add" settings, otherwise they
-			// conflict. Here we loop through all the initial removables from the set
-			// difference, and create a new slice `remove` that contains those settings
-			// found in `rm` but not in `add`
-			var remove []*elasticbeanstalk.ConfigurationOptionSetting
-			if len(add) > 0 {
-				for _, r := range rm {
-					var update = false
-					for _, a := range add {
-						// ResourceNames are optional. Some defaults come with it, some do
-						// not. We need to guard against nil/empty in state as well as
-						// nil/empty from the API
-						if a.ResourceName != nil {
-							if r.ResourceName == nil {
-								continue
-							}
-							if aws.StringValue(r.ResourceName) != aws.StringValue(a.ResourceName) {
-								continue
-							}
-						}
-						if aws.StringValue(r.Namespace) == aws.StringValue(a.Namespace) &&
-							aws.StringValue(r.OptionName) == aws.StringValue(a.OptionName) {
-							log.Printf("[DEBUG] Updating Beanstalk setting (%s::%s) \"%s\" => \"%s\"", *a.Namespace, *a.OptionName, *r.Value, *a.Value)
-							update = true
-							break
-						}
-					}
-					// Only remove options that are not updates
-					if !update {
-						remove = append(remove, r)
-					}
-				}
-			} else {
-				remove = rm
-			}
+			add, remove := DiffOptionSettings(o.(*schema.Set), n.(*schema.Set))
 
 			for _, elem := range remove {
 				input.OptionsToRemove = append(input.OptionsToRemove, &elasticbeanstalk.OptionSpecification{
@@ -560,7 +577,9 @@ func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		opTime := time.Now()
-		_, err := conn.UpdateEnvironmentWithContext(ctx, &input)
+		_, err := newRetryBudgetFromEnv().retryWhenThrottled(ctx, func() (interface{}, error) {
+			return conn.UpdateEnvironmentWithContext(ctx, &input)
+		})
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating Elastic Beanstalk Environment (%s): %s", d.Id(), err)
@@ -680,6 +699,41 @@ func FindEnvironmentByID(ctx context.Context, conn *elasticbeanstalk.ElasticBean
 	return environment, nil
 }
 
+// findEnvironmentByTwoPartKey returns the environment with the specified name in the specified application.
+// Terminated environments are treated as not found.
+func findEnvironmentByTwoPartKey(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, applicationName, environmentName string) (*elasticbeanstalk.EnvironmentDescription, error) {
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{
+		ApplicationName:  aws.String(applicationName),
+		EnvironmentNames: aws.StringSlice([]string{environmentName}),
+		IncludeDeleted:   aws.Bool(false),
+	}
+
+	output, err := conn.DescribeEnvironmentsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Environments) == 0 || output.Environments[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output.Environments); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	environment := output.Environments[0]
+
+	if status := aws.StringValue(environment.Status); status == elasticbeanstalk.EnvironmentStatusTerminated {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return environment, nil
+}
+
 func findEnvironmentErrorsByID(ctx context.Context, conn *elasticbeanstalk.ElasticBeanstalk, id string, since time.Time) error {
 	input := &elasticbeanstalk.DescribeEventsInput{
 		EnvironmentId: aws.String(id),
@@ -810,10 +864,7 @@ func optionSettingValueHash(v interface{}) int {
 	rd := v.(map[string]interface{})
 	namespace := rd["namespace"].(string)
 	optionName := rd["name"].(string)
-	var resourceName string
-	if v, ok := rd["resource"].(string); ok {
-		resourceName = v
-	}
+	resourceName := optionSettingResourceName(rd)
 	value, _ := rd["value"].(string)
 	value, _ = structure.NormalizeJsonString(value)
 	hk := fmt.Sprintf("%s:%s%s=%s", namespace, optionName, resourceName, sortValues(value))
@@ -821,42 +872,136 @@ func optionSettingValueHash(v interface{}) int {
 	return create.StringHashcode(hk)
 }
 
+// optionSettingResourceName returns the option setting's resource name.
+// A setting without a resource, whether the resource is unset, nil or empty, returns "" so that
+// equivalent settings hash identically and do not cause perpetual diffs.
+func optionSettingResourceName(rd map[string]interface{}) string {
+	switch v := rd["resource"].(type) {
+	case string:
+		return v
+	case *string:
+		return aws.StringValue(v)
+	default:
+		return ""
+	}
+}
+
 func optionSettingKeyHash(v interface{}) int {
 	rd := v.(map[string]interface{})
 	namespace := rd["namespace"].(string)
 	optionName := rd["name"].(string)
-	var resourceName string
-	if v, ok := rd["resource"].(string); ok {
-		resourceName = v
-	}
+	resourceName := optionSettingResourceName(rd)
 	hk := fmt.Sprintf("%s:%s%s", namespace, optionName, resourceName)
 	log.Printf("[DEBUG] Elastic Beanstalk optionSettingKeyHash(%#v): %s: hk=%s,hc=%d", v, optionName, hk, create.StringHashcode(hk))
 	return create.StringHashcode(hk)
 }
 
+// ignoreOptionSettingNamespaces replaces the resolved settings in the specified namespaces
+// with the configured ones so that changes made outside of Terraform are not reported as drift.
+func ignoreOptionSettingNamespaces(resolved, configured *schema.Set, namespaces []string) *schema.Set {
+	ignored := make(map[string]struct{}, len(namespaces))
+	for _, namespace := range namespaces {
+		ignored[namespace] = struct{}{}
+	}
+
+	inIgnoredNamespace := func(v interface{}) bool {
+		_, ok := ignored[v.(map[string]interface{})["namespace"].(string)]
+		return ok
+	}
+
+	result := schema.NewSet(optionSettingValueHash, nil)
+
+	for _, v := range resolved.List() {
+		if !inIgnoredNamespace(v) {
+			result.Add(v)
+		}
+	}
+
+	for _, v := range configured.List() {
+		if inIgnoredNamespace(v) {
+			result.Add(v)
+		}
+	}
+
+	return result
+}
+
 func sortValues(v string) string {
 	values := strings.Split(v, ",")
 	sort.Strings(values)
 	return strings.Join(values, ",")
 }
 
-func extractOptionSettings(s *schema.Set) []*elasticbeanstalk.ConfigurationOptionSetting {
-	settings := []*elasticbeanstalk.ConfigurationOptionSetting{}
-
-	if s != nil {
-		for _, setting := range s.List() {
-			optionSetting := elasticbeanstalk.ConfigurationOptionSetting{
-				Namespace:  aws.String(setting.(map[string]interface{})["namespace"].(string)),
-				OptionName: aws.String(setting.(map[string]interface{})["name"].(string)),
-				Value:      aws.String(setting.(map[string]interface{})["value"].(string)),
+// DiffOptionSettings returns the option settings to add and to remove when moving from the old to the new set.
+// Additions and removals of options are done in a single API call, so settings whose value is
+// updated are only added; removing them as well would conflict with the addition.
+func DiffOptionSettings(o, n *schema.Set) (add, remove []*elasticbeanstalk.ConfigurationOptionSetting) {
+	if o == nil {
+		o = &schema.Set{F: optionSettingValueHash}
+	}
+	if n == nil {
+		n = &schema.Set{F: optionSettingValueHash}
+	}
+
+	rm := extractOptionSettings(o.Difference(n))
+	add = extractOptionSettings(n.Difference(o))
+
+	// ResourceNames are optional. Some defaults come with it, some do
+	// not. An added setting without a ResourceName updates the removed
+	// setting with the same namespace and option name regardless of its
+	// ResourceName.
+	updated := make(map[string]struct{}, len(add))
+	for _, a := range add {
+		updated[optionSettingKey(a, a.ResourceName != nil)] = struct{}{}
+	}
+
+	for _, r := range rm {
+		if _, ok := updated[optionSettingKey(r, false)]; ok {
+			continue
+		}
+
+		if r.ResourceName != nil {
+			if _, ok := updated[optionSettingKey(r, true)]; ok {
+				continue
 			}
-			if aws.StringValue(optionSetting.Namespace) == "aws:autoscaling:scheduledaction" {
-				if v, ok := setting.(map[string]interface{})["resource"].(string); ok && v != "" {
-					optionSetting.ResourceName = aws.String(v)
-				}
+		}
+
+		// Only remove options that are not updates
+		remove = append(remove, r)
+	}
+
+	return add, remove
+}
+
+func optionSettingKey(apiObject *elasticbeanstalk.ConfigurationOptionSetting, withResourceName bool) string {
+	key := aws.StringValue(apiObject.Namespace) + ":" + aws.StringValue(apiObject.OptionName)
+
+	if withResourceName {
+		key += ":" + aws.StringValue(apiObject.ResourceName)
+	}
+
+	return key
+}
+
+func extractOptionSettings(s *schema.Set) []*elasticbeanstalk.ConfigurationOptionSetting {
+	if s == nil {
+		return []*elasticbeanstalk.ConfigurationOptionSetting{}
+	}
+
+	settings := make([]*elasticbeanstalk.ConfigurationOptionSetting, 0, s.Len())
+
+	for _, setting := range s.List() {
+		optionSetting := elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String(setting.(map[string]interface{})["namespace"].(string)),
+			OptionName: aws.String(setting.(map[string]interface{})["name"].(string)),
+			Value:      aws.String(setting.(map[string]interface{})["value"].(string)),
+		}
+		if aws.StringValue(optionSetting.Namespace) == "aws:autoscaling:scheduledaction" {
+			if v, ok := setting.(map[string]interface{})["resource"].(string); ok && v != "" {
+				optionSetting.ResourceName = aws.String(v)
 			}
-			settings = append(settings, &optionSetting)
 		}
+		settings = append(settings, &optionSetting)
 	}
 
 	return settings
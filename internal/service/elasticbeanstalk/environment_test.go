@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -20,6 +21,436 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
+func TestDiffOptionSettings(t *testing.T) {
+	t.Parallel()
+
+	setting := func(namespace, name, value string) interface{} {
+		return map[string]interface{}{
+			"namespace": namespace,
+			"name":      name,
+			"value":     value,
+		}
+	}
+	scheduledAction := func(resource, name, value string) interface{} {
+		return map[string]interface{}{
+			"namespace": "aws:autoscaling:scheduledaction",
+			"name":      name,
+			"resource":  resource,
+			"value":     value,
+		}
+	}
+	settingsSet := func(settings ...interface{}) *schema.Set {
+		return schema.NewSet(schema.HashResource(&schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"namespace": {Type: schema.TypeString},
+				"name":      {Type: schema.TypeString},
+				"resource":  {Type: schema.TypeString},
+				"value":     {Type: schema.TypeString},
+			},
+		}), settings)
+	}
+	keys := func(settings []*elasticbeanstalk.ConfigurationOptionSetting) []string {
+		var keys []string
+		for _, v := range settings {
+			key := fmt.Sprintf("%s:%s=%s", aws.StringValue(v.Namespace), aws.StringValue(v.OptionName), aws.StringValue(v.Value))
+			if v.ResourceName != nil {
+				key = aws.StringValue(v.ResourceName) + "/" + key
+			}
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+
+	testCases := map[string]struct {
+		old            *schema.Set
+		new            *schema.Set
+		expectedAdd    []string
+		expectedRemove []string
+	}{
+		"both empty": {
+			old: settingsSet(),
+			new: settingsSet(),
+		},
+		"both nil": {},
+		"old empty": {
+			old: settingsSet(),
+			new: settingsSet(
+				setting("aws:autoscaling:asg", "MinSize", "1"),
+			),
+			expectedAdd: []string{"aws:autoscaling:asg:MinSize=1"},
+		},
+		"new empty": {
+			old: settingsSet(
+				setting("aws:autoscaling:asg", "MinSize", "1"),
+			),
+			new:            settingsSet(),
+			expectedRemove: []string{"aws:autoscaling:asg:MinSize=1"},
+		},
+		"unchanged": {
+			old: settingsSet(
+				setting("aws:autoscaling:asg", "MinSize", "1"),
+			),
+			new: settingsSet(
+				setting("aws:autoscaling:asg", "MinSize", "1"),
+			),
+		},
+		"disjoint": {
+			old: settingsSet(
+				setting("aws:autoscaling:asg", "MinSize", "1"),
+				setting("aws:autoscaling:asg", "MaxSize", "2"),
+			),
+			new: settingsSet(
+				setting("aws:elasticbeanstalk:application:environment", "ENV_STATIC", "true"),
+			),
+			expectedAdd: []string{"aws:elasticbeanstalk:application:environment:ENV_STATIC=true"},
+			expectedRemove: []string{
+				"aws:autoscaling:asg:MaxSize=2",
+				"aws:autoscaling:asg:MinSize=1",
+			},
+		},
+		"overlapping": {
+			old: settingsSet(
+				setting("aws:autoscaling:asg", "MinSize", "1"),
+				setting("aws:autoscaling:asg", "MaxSize", "2"),
+				setting("aws:autoscaling:launchconfiguration", "InstanceType", "t2.micro"),
+				setting("aws:elasticbeanstalk:application:environment", "ENV_STATIC", "true"),
+			),
+			new: settingsSet(
+				setting("aws:autoscaling:asg", "MinSize", "1"),
+				setting("aws:autoscaling:asg", "MaxSize", "4"),
+				setting("aws:autoscaling:launchconfiguration", "InstanceType", "t3.micro"),
+				setting("aws:elasticbeanstalk:application:environment", "ENV_UPDATE", "true"),
+			),
+			expectedAdd: []string{
+				"aws:autoscaling:asg:MaxSize=4",
+				"aws:autoscaling:launchconfiguration:InstanceType=t3.micro",
+				"aws:elasticbeanstalk:application:environment:ENV_UPDATE=true",
+			},
+			expectedRemove: []string{"aws:elasticbeanstalk:application:environment:ENV_STATIC=true"},
+		},
+		"multiple overlapping adds": {
+			old: settingsSet(
+				setting("aws:autoscaling:asg", "MinSize", "1"),
+				setting("aws:autoscaling:asg", "MaxSize", "2"),
+				setting("aws:elasticbeanstalk:application:environment", "ENV_REMOVE1", "true"),
+				setting("aws:elasticbeanstalk:application:environment", "ENV_REMOVE2", "true"),
+			),
+			new: settingsSet(
+				setting("aws:autoscaling:asg", "MinSize", "2"),
+				setting("aws:autoscaling:asg", "MaxSize", "4"),
+				setting("aws:elasticbeanstalk:application:environment", "ENV_ADD1", "true"),
+				setting("aws:elasticbeanstalk:application:environment", "ENV_ADD2", "true"),
+				setting("aws:elasticbeanstalk:application:environment", "ENV_ADD3", "true"),
+			),
+			expectedAdd: []string{
+				"aws:autoscaling:asg:MaxSize=4",
+				"aws:autoscaling:asg:MinSize=2",
+				"aws:elasticbeanstalk:application:environment:ENV_ADD1=true",
+				"aws:elasticbeanstalk:application:environment:ENV_ADD2=true",
+				"aws:elasticbeanstalk:application:environment:ENV_ADD3=true",
+			},
+			expectedRemove: []string{
+				"aws:elasticbeanstalk:application:environment:ENV_REMOVE1=true",
+				"aws:elasticbeanstalk:application:environment:ENV_REMOVE2=true",
+			},
+		},
+		"resource names": {
+			old: settingsSet(
+				scheduledAction("ScaleUp", "MinSize", "1"),
+				scheduledAction("ScaleDown", "MinSize", "1"),
+				scheduledAction("Nightly", "MinSize", "1"),
+			),
+			new: settingsSet(
+				scheduledAction("ScaleUp", "MinSize", "2"),
+				scheduledAction("ScaleOut", "MinSize", "1"),
+			),
+			expectedAdd: []string{
+				"ScaleOut/aws:autoscaling:scheduledaction:MinSize=1",
+				"ScaleUp/aws:autoscaling:scheduledaction:MinSize=2",
+			},
+			expectedRemove: []string{
+				"Nightly/aws:autoscaling:scheduledaction:MinSize=1",
+				"ScaleDown/aws:autoscaling:scheduledaction:MinSize=1",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			add, remove := tfelasticbeanstalk.DiffOptionSettings(testCase.old, testCase.new)
+
+			if got, expected := keys(add), testCase.expectedAdd; !reflect.DeepEqual(got, expected) {
+				t.Errorf("got add %v, expected %v", got, expected)
+			}
+
+			if got, expected := keys(remove), testCase.expectedRemove; !reflect.DeepEqual(got, expected) {
+				t.Errorf("got remove %v, expected %v", got, expected)
+			}
+
+			seen := make(map[string]bool)
+			for _, v := range keys(remove) {
+				if seen[v] {
+					t.Errorf("duplicate remove %s", v)
+				}
+				seen[v] = true
+			}
+		})
+	}
+}
+
+func TestOptionSettingHash(t *testing.T) {
+	t.Parallel()
+
+	const (
+		namespace = "aws:autoscaling:launchconfiguration"
+		name      = "InstanceType"
+	)
+
+	testCases := map[string]struct {
+		setting  map[string]interface{}
+		expected map[string]interface{}
+	}{
+		"empty resource": {
+			setting: map[string]interface{}{
+				"namespace": namespace,
+				"name":      name,
+				"resource":  "",
+				"value":     "t3.micro",
+			},
+		},
+		"nil resource": {
+			setting: map[string]interface{}{
+				"namespace": namespace,
+				"name":      name,
+				"resource":  nil,
+				"value":     "t3.micro",
+			},
+		},
+		"nil string pointer resource": {
+			setting: map[string]interface{}{
+				"namespace": namespace,
+				"name":      name,
+				"resource":  (*string)(nil),
+				"value":     "t3.micro",
+			},
+		},
+		"empty string pointer resource": {
+			setting: map[string]interface{}{
+				"namespace": namespace,
+				"name":      name,
+				"resource":  aws.String(""),
+				"value":     "t3.micro",
+			},
+		},
+		"string pointer resource": {
+			setting: map[string]interface{}{
+				"namespace": "aws:autoscaling:scheduledaction",
+				"name":      "MinSize",
+				"resource":  aws.String("ScheduledAction01"),
+				"value":     "1",
+			},
+			expected: map[string]interface{}{
+				"namespace": "aws:autoscaling:scheduledaction",
+				"name":      "MinSize",
+				"resource":  "ScheduledAction01",
+				"value":     "1",
+			},
+		},
+	}
+
+	// The setting as flattened from the API, without a resource.
+	unset := map[string]interface{}{
+		"namespace": namespace,
+		"name":      name,
+		"value":     "t3.micro",
+	}
+
+	// A blank resource is sent to the API unchanged, so it is not treated as unset.
+	blank := map[string]interface{}{
+		"namespace": namespace,
+		"name":      name,
+		"resource":  " ",
+		"value":     "t3.micro",
+	}
+
+	if tfelasticbeanstalk.OptionSettingValueHash(blank) == tfelasticbeanstalk.OptionSettingValueHash(unset) {
+		t.Errorf("blank resource: got the same value hash as a setting without a resource")
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expected := testCase.expected
+			if expected == nil {
+				expected = unset
+			}
+
+			if got, expected := tfelasticbeanstalk.OptionSettingValueHash(testCase.setting), tfelasticbeanstalk.OptionSettingValueHash(expected); got != expected {
+				t.Errorf("got value hash %d, expected %d", got, expected)
+			}
+
+			if got, expected := tfelasticbeanstalk.OptionSettingKeyHash(testCase.setting), tfelasticbeanstalk.OptionSettingKeyHash(expected); got != expected {
+				t.Errorf("got key hash %d, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+func TestIgnoreOptionSettingNamespaces(t *testing.T) {
+	t.Parallel()
+
+	setting := func(namespace, name, value string) interface{} {
+		return map[string]interface{}{
+			"namespace": namespace,
+			"name":      name,
+			"value":     value,
+		}
+	}
+	settingsSet := func(settings ...interface{}) *schema.Set {
+		return schema.NewSet(schema.HashResource(&schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"namespace": {Type: schema.TypeString},
+				"name":      {Type: schema.TypeString},
+				"value":     {Type: schema.TypeString},
+			},
+		}), settings)
+	}
+
+	// The configured autoscaling settings have been adjusted outside of Terraform.
+	configured := settingsSet(
+		setting("aws:autoscaling:asg", "MinSize", "1"),
+		setting("aws:autoscaling:asg", "MaxSize", "2"),
+		setting("aws:elasticbeanstalk:application:environment", "ENV_STATIC", "true"),
+	)
+	resolved := settingsSet(
+		setting("aws:autoscaling:asg", "MinSize", "3"),
+		setting("aws:autoscaling:asg", "MaxSize", "6"),
+		setting("aws:elasticbeanstalk:application:environment", "ENV_STATIC", "false"),
+	)
+
+	testCases := map[string]struct {
+		namespaces []string
+		expected   []string
+	}{
+		"no namespaces": {
+			expected: []string{
+				"aws:autoscaling:asg:MaxSize=6",
+				"aws:autoscaling:asg:MinSize=3",
+				"aws:elasticbeanstalk:application:environment:ENV_STATIC=false",
+			},
+		},
+		"ignored namespace": {
+			namespaces: []string{"aws:autoscaling:asg"},
+			expected: []string{
+				"aws:autoscaling:asg:MaxSize=2",
+				"aws:autoscaling:asg:MinSize=1",
+				"aws:elasticbeanstalk:application:environment:ENV_STATIC=false",
+			},
+		},
+		"unknown namespace": {
+			namespaces: []string{"aws:elb:loadbalancer"},
+			expected: []string{
+				"aws:autoscaling:asg:MaxSize=6",
+				"aws:autoscaling:asg:MinSize=3",
+				"aws:elasticbeanstalk:application:environment:ENV_STATIC=false",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range tfelasticbeanstalk.IgnoreOptionSettingNamespaces(resolved, configured, testCase.namespaces).List() {
+				m := v.(map[string]interface{})
+				got = append(got, fmt.Sprintf("%s:%s=%s", m["namespace"], m["name"], m["value"]))
+			}
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestValidateOptionSettingValues(t *testing.T) {
+	t.Parallel()
+
+	setting := func(namespace, name, value string) interface{} {
+		return map[string]interface{}{
+			"namespace": namespace,
+			"name":      name,
+			"value":     value,
+		}
+	}
+
+	testCases := map[string]struct {
+		settings    []interface{}
+		expectError bool
+	}{
+		"no settings": {},
+		"recognized option valid value": {
+			settings: []interface{}{
+				setting("aws:elasticbeanstalk:environment", "EnvironmentType", "SingleInstance"),
+				setting("aws:elasticbeanstalk:environment", "LoadBalancerType", "application"),
+			},
+		},
+		"recognized option invalid value": {
+			settings: []interface{}{
+				setting("aws:elasticbeanstalk:environment", "EnvironmentType", "MultiInstance"),
+			},
+			expectError: true,
+		},
+		"recognized option wrong case": {
+			settings: []interface{}{
+				setting("aws:elasticbeanstalk:environment", "EnvironmentType", "singleinstance"),
+			},
+			expectError: true,
+		},
+		"recognized option empty value": {
+			settings: []interface{}{
+				setting("aws:elasticbeanstalk:environment", "EnvironmentType", ""),
+			},
+		},
+		"unknown option": {
+			settings: []interface{}{
+				setting("aws:autoscaling:launchconfiguration", "InstanceType", "anything"),
+				setting("aws:elasticbeanstalk:application:environment", "EnvironmentType", "MultiInstance"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfelasticbeanstalk.ValidateOptionSettingValues(testCase.settings)
+
+			if testCase.expectError && err == nil {
+				t.Fatal("expected error")
+			}
+
+			if !testCase.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccElasticBeanstalkEnvironment_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var app elasticbeanstalk.EnvironmentDescription
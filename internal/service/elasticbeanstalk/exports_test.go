@@ -0,0 +1,19 @@
+package elasticbeanstalk
+
+// Exports for use in tests only.
+var (
+	ConfigurationTemplateImportWarning                = configurationTemplateImportWarning
+	ConfigurationTemplateParseARN                     = configurationTemplateParseARN
+	ConfigurationTemplateValidationDiags              = configurationTemplateValidationDiags
+	ConfiguredOptionSettings                          = configuredOptionSettings
+	FindConfigurationTemplatePlatformByTwoPartKey     = findConfigurationTemplatePlatformByTwoPartKey
+	FindEnvironmentByTwoPartKey                       = findEnvironmentByTwoPartKey
+	FlattenPlatformDescription                        = flattenPlatformDescription
+	IgnoreOptionSettingNamespaces                     = ignoreOptionSettingNamespaces
+	OptionSettingKeyHash                              = optionSettingKeyHash
+	OptionSettingValueHash                            = optionSettingValueHash
+	OptionSettingsJSON                                = optionSettingsJSON
+	ResourceConfigurationTemplateOptionSettingsUpdate = resourceConfigurationTemplateOptionSettingsUpdate
+	SuppressEquivalentSolutionStackNames              = suppressEquivalentSolutionStackNames
+	ValidateOptionSettingValues                       = validateOptionSettingValues
+)
@@ -94,3 +94,103 @@ func flattenResourceLifecycleConfig(rlc *elasticbeanstalk.ApplicationResourceLif
 
 	return result
 }
+
+func flattenConfigurationOptionSettings(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name":      aws.StringValue(apiObject.OptionName),
+			"namespace": aws.StringValue(apiObject.Namespace),
+			"value":     aws.StringValue(apiObject.Value),
+		}
+
+		if v := apiObject.ResourceName; v != nil {
+			tfMap["resource"] = aws.StringValue(v)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+// flattenEnvironmentVariables returns the option settings in the application environment namespace as a map of
+// environment variable names to values.
+func flattenEnvironmentVariables(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting) map[string]string {
+	tfMap := make(map[string]string)
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil || aws.StringValue(apiObject.Namespace) != "aws:elasticbeanstalk:application:environment" {
+			continue
+		}
+
+		tfMap[aws.StringValue(apiObject.OptionName)] = aws.StringValue(apiObject.Value)
+	}
+
+	return tfMap
+}
+
+// flattenConfigurationOptionSettingsMap returns the option settings as a map of "namespace:option_name" to value.
+// If settings for different resources share a key, the setting without a resource name is used,
+// otherwise the setting whose resource name sorts first.
+func flattenConfigurationOptionSettingsMap(apiObjects []*elasticbeanstalk.ConfigurationOptionSetting) map[string]string {
+	tfMap := make(map[string]string)
+	resourceNames := make(map[string]string)
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		key := aws.StringValue(apiObject.Namespace) + ":" + aws.StringValue(apiObject.OptionName)
+		resourceName := aws.StringValue(apiObject.ResourceName)
+
+		if v, ok := resourceNames[key]; ok && v <= resourceName {
+			continue
+		}
+
+		tfMap[key] = aws.StringValue(apiObject.Value)
+		resourceNames[key] = resourceName
+	}
+
+	return tfMap
+}
+
+func flattenPlatformDescription(apiObject *elasticbeanstalk.PlatformDescription) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"operating_system_name":    aws.StringValue(apiObject.OperatingSystemName),
+		"operating_system_version": aws.StringValue(apiObject.OperatingSystemVersion),
+		"platform_arn":             aws.StringValue(apiObject.PlatformArn),
+		"platform_branch_name":     aws.StringValue(apiObject.PlatformBranchName),
+		"platform_lifecycle_state": aws.StringValue(apiObject.PlatformLifecycleState),
+		"programming_language":     flattenPlatformProgrammingLanguages(apiObject.ProgrammingLanguages),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPlatformProgrammingLanguages(apiObjects []*elasticbeanstalk.PlatformProgrammingLanguage) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":    aws.StringValue(apiObject.Name),
+			"version": aws.StringValue(apiObject.Version),
+		})
+	}
+
+	return tfList
+}
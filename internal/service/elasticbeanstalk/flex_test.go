@@ -0,0 +1,142 @@
+package elasticbeanstalk
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func testOptionSettings(n int) []*elasticbeanstalk.ConfigurationOptionSetting {
+	optionSettings := make([]*elasticbeanstalk.ConfigurationOptionSetting, 0, n)
+
+	for i := 0; i < n; i++ {
+		optionSettings = append(optionSettings, &elasticbeanstalk.ConfigurationOptionSetting{
+			Namespace:  aws.String("aws:elasticbeanstalk:application:environment"),
+			OptionName: aws.String(fmt.Sprintf("VARIABLE_%03d", i)),
+			Value:      aws.String(fmt.Sprintf("value-%03d", i)),
+		})
+	}
+
+	return optionSettings
+}
+
+func TestFlattenConfigurationOptionSettings_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	const n = 500
+	optionSettings := testOptionSettings(n)
+
+	s := schema.NewSet(optionSettingValueHash, flattenConfigurationOptionSettings(optionSettings))
+
+	if got := s.Len(); got != n {
+		t.Fatalf("expected %d settings in set, got %d", n, got)
+	}
+
+	got := make(map[string]string, n)
+	for _, v := range extractOptionSettings(s) {
+		got[aws.StringValue(v.OptionName)] = aws.StringValue(v.Value)
+	}
+
+	if len(got) != n {
+		t.Fatalf("expected %d extracted settings, got %d", n, len(got))
+	}
+
+	for _, v := range optionSettings {
+		if got, want := got[aws.StringValue(v.OptionName)], aws.StringValue(v.Value); got != want {
+			t.Errorf("setting %s = %q, want %q", aws.StringValue(v.OptionName), got, want)
+		}
+	}
+}
+
+func TestFlattenEnvironmentVariables(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []*elasticbeanstalk.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String("aws:elasticbeanstalk:application:environment"),
+			OptionName: aws.String("DATABASE_URL"),
+			Value:      aws.String("postgres://localhost/test"),
+		},
+		{
+			Namespace:  aws.String("aws:elasticbeanstalk:application:environment"),
+			OptionName: aws.String("EMPTY"),
+		},
+		{
+			Namespace:  aws.String("aws:autoscaling:asg"),
+			OptionName: aws.String("MinSize"),
+			Value:      aws.String("1"),
+		},
+		nil,
+	}
+
+	got := flattenEnvironmentVariables(apiObjects)
+	expected := map[string]string{
+		"DATABASE_URL": "postgres://localhost/test",
+		"EMPTY":        "",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func TestFlattenConfigurationOptionSettingsMap(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []*elasticbeanstalk.ConfigurationOptionSetting{
+		{
+			Namespace:  aws.String("aws:autoscaling:launchconfiguration"),
+			OptionName: aws.String("InstanceType"),
+			Value:      aws.String("t3.micro"),
+		},
+		{
+			Namespace:    aws.String("aws:autoscaling:scheduledaction"),
+			OptionName:   aws.String("MinSize"),
+			ResourceName: aws.String("ScaleUp"),
+			Value:        aws.String("4"),
+		},
+		{
+			Namespace:    aws.String("aws:autoscaling:scheduledaction"),
+			OptionName:   aws.String("MinSize"),
+			ResourceName: aws.String("ScaleDown"),
+			Value:        aws.String("1"),
+		},
+		{
+			Namespace:    aws.String("aws:autoscaling:asg"),
+			OptionName:   aws.String("MaxSize"),
+			ResourceName: aws.String("Group"),
+			Value:        aws.String("8"),
+		},
+		{
+			Namespace:  aws.String("aws:autoscaling:asg"),
+			OptionName: aws.String("MaxSize"),
+			Value:      aws.String("2"),
+		},
+		nil,
+	}
+
+	got := flattenConfigurationOptionSettingsMap(apiObjects)
+	expected := map[string]string{
+		"aws:autoscaling:asg:MaxSize":                      "2",
+		"aws:autoscaling:launchconfiguration:InstanceType": "t3.micro",
+		"aws:autoscaling:scheduledaction:MinSize":          "1",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("got %v, expected %v", got, expected)
+	}
+}
+
+func BenchmarkFlattenConfigurationOptionSettings(b *testing.B) {
+	optionSettings := testOptionSettings(500)
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		extractOptionSettings(schema.NewSet(optionSettingValueHash, flattenConfigurationOptionSettings(optionSettings)))
+	}
+}
@@ -0,0 +1,102 @@
+package elasticbeanstalk
+
+import (
+	"context"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+)
+
+const (
+	// Maximum number of attempts made for an Elastic Beanstalk create or update call that is throttled.
+	retryMaxAttemptsEnvVar = "TF_AWS_ELASTICBEANSTALK_RETRY_MAX_ATTEMPTS"
+
+	// Maximum duration, e.g. "5m", spent retrying a throttled Elastic Beanstalk create or update call.
+	retryMaxDurationEnvVar = "TF_AWS_ELASTICBEANSTALK_RETRY_MAX_DURATION"
+)
+
+const (
+	errCodeThrottling               = "Throttling"
+	errCodeTooManyRequestsException = "TooManyRequestsException"
+)
+
+const (
+	// Maximum duration spent retrying a throttled Elastic Beanstalk read call.
+	readThrottleTimeout = 2 * time.Minute
+)
+
+const (
+	retryBudgetDefaultMaxAttempts = 1
+	retryBudgetDelay              = 5 * time.Second
+)
+
+// retryBudget bounds how many times, and for how long, a throttled Elastic Beanstalk
+// create or update call is retried. The default budget makes a single attempt,
+// leaving retries to the AWS SDK as before. A zero maxAttempts places no limit on the attempts.
+type retryBudget struct {
+	maxAttempts int
+	maxDuration time.Duration
+	delay       time.Duration
+}
+
+func newRetryBudgetFromEnv() *retryBudget {
+	budget := &retryBudget{
+		maxAttempts: retryBudgetDefaultMaxAttempts,
+		delay:       retryBudgetDelay,
+	}
+
+	var maxAttemptsSet bool
+
+	if v := os.Getenv(retryMaxAttemptsEnvVar); v != "" {
+		if n, err := strconv.Atoi(v); err != nil || n < 1 {
+			log.Printf("[WARN] Ignoring invalid %s value (%s)", retryMaxAttemptsEnvVar, v)
+		} else {
+			budget.maxAttempts = n
+			maxAttemptsSet = true
+		}
+	}
+
+	if v := os.Getenv(retryMaxDurationEnvVar); v != "" {
+		if d, err := time.ParseDuration(v); err != nil || d < 0 {
+			log.Printf("[WARN] Ignoring invalid %s value (%s)", retryMaxDurationEnvVar, v)
+		} else {
+			budget.maxDuration = d
+		}
+	}
+
+	// A duration on its own bounds the retries by time alone.
+	if !maxAttemptsSet && budget.maxDuration > 0 {
+		budget.maxAttempts = 0
+	}
+
+	return budget
+}
+
+// retryWhenThrottled calls f until it succeeds, fails with a non-throttling error or the budget is spent.
+// A zero maxDuration places no time limit on the retries.
+func (b *retryBudget) retryWhenThrottled(ctx context.Context, f func() (interface{}, error)) (interface{}, error) {
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		output, err := f()
+
+		if !tfawserr.ErrCodeEquals(err, errCodeThrottling, errCodeTooManyRequestsException) {
+			return output, err
+		}
+
+		if (b.maxAttempts > 0 && attempt >= b.maxAttempts) || (b.maxDuration > 0 && time.Since(start)+b.delay > b.maxDuration) {
+			return output, err
+		}
+
+		log.Printf("[DEBUG] Retrying throttled Elastic Beanstalk request (attempt %d)", attempt+1)
+
+		select {
+		case <-ctx.Done():
+			return output, err
+		case <-time.After(b.delay):
+		}
+	}
+}
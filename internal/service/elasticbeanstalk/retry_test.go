@@ -0,0 +1,140 @@
+package elasticbeanstalk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestNewRetryBudgetFromEnv(t *testing.T) {
+	testCases := map[string]struct {
+		maxAttempts         string
+		maxDuration         string
+		expectedMaxAttempts int
+		expectedMaxDuration time.Duration
+	}{
+		"unset": {
+			expectedMaxAttempts: 1,
+		},
+		"valid": {
+			maxAttempts:         "5",
+			maxDuration:         "2m",
+			expectedMaxAttempts: 5,
+			expectedMaxDuration: 2 * time.Minute,
+		},
+		"invalid": {
+			maxAttempts:         "0",
+			maxDuration:         "forever",
+			expectedMaxAttempts: 1,
+		},
+		"duration only": {
+			maxDuration:         "2m",
+			expectedMaxAttempts: 0,
+			expectedMaxDuration: 2 * time.Minute,
+		},
+		"zero duration only": {
+			maxDuration:         "0s",
+			expectedMaxAttempts: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(retryMaxAttemptsEnvVar, testCase.maxAttempts)
+			t.Setenv(retryMaxDurationEnvVar, testCase.maxDuration)
+
+			got := newRetryBudgetFromEnv()
+
+			if got.maxAttempts != testCase.expectedMaxAttempts {
+				t.Errorf("got maxAttempts %d, expected %d", got.maxAttempts, testCase.expectedMaxAttempts)
+			}
+
+			if got.maxDuration != testCase.expectedMaxDuration {
+				t.Errorf("got maxDuration %s, expected %s", got.maxDuration, testCase.expectedMaxDuration)
+			}
+		})
+	}
+}
+
+func TestRetryBudgetRetryWhenThrottled(t *testing.T) {
+	t.Parallel()
+
+	throttlingErr := awserr.New(errCodeThrottling, "Rate exceeded", nil)
+	tooManyRequestsErr := awserr.New(errCodeTooManyRequestsException, "Too many requests", nil)
+
+	testCases := map[string]struct {
+		budget           retryBudget
+		errs             []error
+		expectedAttempts int
+		expectError      bool
+	}{
+		"default budget": {
+			budget:           retryBudget{maxAttempts: 1},
+			errs:             []error{throttlingErr, nil},
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+		"succeeds within budget": {
+			budget:           retryBudget{maxAttempts: 3},
+			errs:             []error{throttlingErr, throttlingErr, nil},
+			expectedAttempts: 3,
+		},
+		"too many requests": {
+			budget:           retryBudget{maxAttempts: 3},
+			errs:             []error{tooManyRequestsErr, throttlingErr, nil},
+			expectedAttempts: 3,
+		},
+		"attempts exhausted": {
+			budget:           retryBudget{maxAttempts: 3},
+			errs:             []error{throttlingErr, throttlingErr, throttlingErr, nil},
+			expectedAttempts: 3,
+			expectError:      true,
+		},
+		"duration exhausted": {
+			budget:           retryBudget{maxAttempts: 10, maxDuration: time.Millisecond, delay: time.Second},
+			errs:             []error{throttlingErr, nil},
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+		"duration only": {
+			budget:           retryBudget{maxDuration: time.Minute, delay: time.Millisecond},
+			errs:             []error{throttlingErr, throttlingErr, tooManyRequestsErr, nil},
+			expectedAttempts: 4,
+		},
+		"non-retryable error": {
+			budget:           retryBudget{maxAttempts: 3},
+			errs:             []error{errors.New("test"), nil},
+			expectedAttempts: 1,
+			expectError:      true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int
+			_, err := testCase.budget.retryWhenThrottled(context.Background(), func() (interface{}, error) {
+				err := testCase.errs[attempts]
+				attempts++
+
+				return nil, err
+			})
+
+			if attempts != testCase.expectedAttempts {
+				t.Errorf("got %d attempts, expected %d", attempts, testCase.expectedAttempts)
+			}
+
+			if got, expected := err != nil, testCase.expectError; got != expected {
+				t.Errorf("got error %v, expected error: %t", err, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceClusterSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceClusterSnapshotCopyCreate,
+		ReadWithoutTimeout:   resourceClusterSnapshotCopyRead,
+		UpdateWithoutTimeout: resourceClusterSnapshotCopyUpdate,
+		DeleteWithoutTimeout: resourceClusterSnapshotCopyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceClusterSnapshotCopyImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allocated_storage": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"availability_zones": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"copy_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"db_cluster_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"engine": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"license_model": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"snapshot_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_db_cluster_snapshot_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_db_cluster_snapshot_identifier": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEquivalentClusterSnapshotIdentifiers,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"storage_encrypted": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"target_db_cluster_snapshot_identifier": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 63),
+					validation.StringMatch(regexp.MustCompile(`^[0-9a-z-]+$`), "must contain only lowercase alphanumeric characters and hyphens"),
+					validation.StringMatch(regexp.MustCompile(`^[a-z]`), "must begin with a lowercase letter"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+					validation.StringDoesNotMatch(regexp.MustCompile(`-$`), "cannot end with a hyphen"),
+				),
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceClusterSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := clusterSnapshotRegionConn(client, d.Get("destination_region").(string))
+	defaultTagsConfig := client.DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	id := d.Get("target_db_cluster_snapshot_identifier").(string)
+	input := &rds.CopyDBClusterSnapshotInput{
+		SourceDBClusterSnapshotIdentifier: aws.String(d.Get("source_db_cluster_snapshot_identifier").(string)),
+		TargetDBClusterSnapshotIdentifier: aws.String(id),
+		Tags:                              Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("copy_tags"); ok {
+		input.CopyTags = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("destination_region"); ok && v.(string) != client.Region {
+		// The source snapshot is in the provider's region. The AWS SDK presigns the request for the source region.
+		input.SourceRegion = aws.String(client.Region)
+	}
+
+	if v, ok := d.GetOk("kms_key_id"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	_, err := conn.CopyDBClusterSnapshotWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating RDS DB Cluster Snapshot Copy (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitDBClusterSnapshotCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Cluster Snapshot Copy (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceClusterSnapshotCopyRead(ctx, d, meta)...)
+}
+
+func resourceClusterSnapshotCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	client := meta.(*conns.AWSClient)
+	conn := clusterSnapshotRegionConn(client, d.Get("destination_region").(string))
+	defaultTagsConfig := client.DefaultTagsConfig
+	ignoreTagsConfig := client.IgnoreTagsConfig
+
+	snapshot, err := FindDBClusterSnapshotByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] RDS DB Cluster Snapshot Copy (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading RDS DB Cluster Snapshot Copy (%s): %s", d.Id(), err)
+	}
+
+	arn := aws.StringValue(snapshot.DBClusterSnapshotArn)
+
+	d.Set("allocated_storage", snapshot.AllocatedStorage)
+	if err := d.Set("availability_zones", flex.FlattenStringList(snapshot.AvailabilityZones)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting availability_zones: %s", err)
+	}
+	d.Set("db_cluster_snapshot_arn", arn)
+	d.Set("engine", snapshot.Engine)
+	d.Set("engine_version", snapshot.EngineVersion)
+	d.Set("kms_key_id", snapshot.KmsKeyId)
+	d.Set("license_model", snapshot.LicenseModel)
+	d.Set("port", snapshot.Port)
+	d.Set("snapshot_type", snapshot.SnapshotType)
+	d.Set("source_db_cluster_snapshot_arn", snapshot.SourceDBClusterSnapshotArn)
+	d.Set("source_db_cluster_snapshot_identifier", snapshot.SourceDBClusterSnapshotArn)
+	d.Set("status", snapshot.Status)
+	d.Set("storage_encrypted", snapshot.StorageEncrypted)
+	d.Set("target_db_cluster_snapshot_identifier", snapshot.DBClusterSnapshotIdentifier)
+	d.Set("vpc_id", snapshot.VpcId)
+
+	tags, err := ListTags(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing tags for RDS DB Cluster Snapshot Copy (%s): %s", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting tags_all: %s", err)
+	}
+
+	return diags
+}
+
+func resourceClusterSnapshotCopyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := clusterSnapshotRegionConn(meta.(*conns.AWSClient), d.Get("destination_region").(string))
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(ctx, conn, d.Get("db_cluster_snapshot_arn").(string), o, n); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating RDS DB Cluster Snapshot Copy (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceClusterSnapshotCopyRead(ctx, d, meta)...)
+}
+
+func resourceClusterSnapshotCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := clusterSnapshotRegionConn(meta.(*conns.AWSClient), d.Get("destination_region").(string))
+
+	log.Printf("[DEBUG] Deleting RDS DB Cluster Snapshot Copy: %s", d.Id())
+	_, err := conn.DeleteDBClusterSnapshotWithContext(ctx, &rds.DeleteDBClusterSnapshotInput{
+		DBClusterSnapshotIdentifier: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterSnapshotNotFoundFault) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting RDS DB Cluster Snapshot Copy (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// resourceClusterSnapshotCopyImport imports a copy by its identifier or, for a copy in a region other than the
+// provider region, by an ID of the form region/identifier. The region is set as destination_region so that the
+// copy is read from that region.
+func resourceClusterSnapshotCopyImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	region, id, err := clusterSnapshotCopyParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	if region != "" {
+		d.Set("destination_region", region)
+	}
+
+	d.SetId(id)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// clusterSnapshotCopyParseImportID returns the region, if any, and the snapshot identifier in the specified import ID.
+func clusterSnapshotCopyParseImportID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return "", parts[0], nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		if _, errs := verify.ValidRegionName(parts[0], "destination_region"); len(errs) > 0 {
+			return "", "", fmt.Errorf("unexpected format for ID (%s): %w", id, errs[0])
+		}
+
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected IDENTIFIER or REGION/IDENTIFIER", id)
+}
+
+// suppressEquivalentClusterSnapshotIdentifiers suppresses differences between a DB cluster snapshot identifier and its ARN.
+// The source snapshot is read back as an ARN, whereas it may be configured by its identifier.
+func suppressEquivalentClusterSnapshotIdentifiers(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	oldID, oldIsARN := clusterSnapshotIdentifierFromARN(old)
+	newID, newIsARN := clusterSnapshotIdentifierFromARN(new)
+
+	// Two different ARNs may refer to snapshots with the same identifier in different regions or accounts.
+	if oldIsARN == newIsARN {
+		return false
+	}
+
+	return oldID == newID
+}
+
+// clusterSnapshotIdentifierFromARN returns the snapshot identifier in the specified DB cluster snapshot ARN and true,
+// or the input unchanged and false if it is not such an ARN.
+func clusterSnapshotIdentifierFromARN(s string) (string, bool) {
+	const prefix = "cluster-snapshot:"
+
+	v, err := arn.Parse(s)
+
+	if err != nil || !strings.HasPrefix(v.Resource, prefix) {
+		return s, false
+	}
+
+	return strings.TrimPrefix(v.Resource, prefix), true
+}
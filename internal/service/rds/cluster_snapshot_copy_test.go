@@ -0,0 +1,384 @@
+package rds_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/rds"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccRDSClusterSnapshotCopy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBClusterSnapshot
+	resourceName := "aws_rds_cluster_snapshot_copy.test"
+	sourceResourceName := "aws_db_cluster_snapshot.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterSnapshotCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotCopyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotCopyExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "db_cluster_snapshot_arn", "rds", regexp.MustCompile(fmt.Sprintf("cluster-snapshot:%s-target$", rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "engine", sourceResourceName, "engine"),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", sourceResourceName, "engine_version"),
+					resource.TestCheckResourceAttr(resourceName, "snapshot_type", "manual"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_snapshot_arn", sourceResourceName, "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_snapshot_identifier", sourceResourceName, "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+					resource.TestCheckResourceAttr(resourceName, "storage_encrypted", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_db_cluster_snapshot_identifier", rName+"-target"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSClusterSnapshotCopy_destinationRegion(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBClusterSnapshot
+	resourceName := "aws_rds_cluster_snapshot_copy.test"
+	sourceResourceName := "aws_db_cluster_snapshot.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterSnapshotCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotCopyConfig_destinationRegion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotCopyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "destination_region", acctest.AlternateRegion()),
+					resource.TestMatchResourceAttr(resourceName, "db_cluster_snapshot_arn", regexp.MustCompile(fmt.Sprintf(`^arn:[^:]+:rds:%s:[^:]+:cluster-snapshot:%s-target$`, acctest.AlternateRegion(), rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_snapshot_arn", sourceResourceName, "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_db_cluster_snapshot_identifier", sourceResourceName, "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", "available"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccClusterSnapshotCopyImportStateIDFunc(resourceName),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestClusterSnapshotCopyParseImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID       string
+		expectedRegion string
+		expectedID     string
+		expectError    bool
+	}{
+		"identifier": {
+			importID:   "my-snapshot-copy",
+			expectedID: "my-snapshot-copy",
+		},
+		"region and identifier": {
+			importID:       "us-west-2/my-snapshot-copy", //lintignore:AWSAT003
+			expectedRegion: "us-west-2",                  //lintignore:AWSAT003
+			expectedID:     "my-snapshot-copy",
+		},
+		"empty": {
+			importID:    "",
+			expectError: true,
+		},
+		"empty identifier": {
+			importID:    "us-west-2/", //lintignore:AWSAT003
+			expectError: true,
+		},
+		"invalid region": {
+			importID:    "not_a_region/my-snapshot-copy",
+			expectError: true,
+		},
+		"too many parts": {
+			importID:    "us-west-2/my-snapshot-copy/extra", //lintignore:AWSAT003
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			region, id, err := tfrds.ClusterSnapshotCopyParseImportID(testCase.importID)
+
+			if got, expected := err != nil, testCase.expectError; got != expected {
+				t.Fatalf("got error %v, expected error: %t", err, expected)
+			}
+
+			if region != testCase.expectedRegion {
+				t.Errorf("got region %q, expected %q", region, testCase.expectedRegion)
+			}
+
+			if id != testCase.expectedID {
+				t.Errorf("got ID %q, expected %q", id, testCase.expectedID)
+			}
+		})
+	}
+}
+
+func TestSuppressEquivalentClusterSnapshotIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	const (
+		snapshotARN      = "arn:aws:rds:us-west-2:123456789012:cluster-snapshot:tf-acc-test"              //lintignore:AWSAT003,AWSAT005
+		otherRegionARN   = "arn:aws:rds:us-east-1:123456789012:cluster-snapshot:tf-acc-test"              //lintignore:AWSAT003,AWSAT005
+		automatedARN     = "arn:aws:rds:us-west-2:123456789012:cluster-snapshot:rds:mycluster-2024-01-02" //lintignore:AWSAT003,AWSAT005
+		otherResourceARN = "arn:aws:rds:us-west-2:123456789012:snapshot:tf-acc-test"                      //lintignore:AWSAT003,AWSAT005
+	)
+
+	testCases := map[string]struct {
+		old, new string
+		expected bool
+	}{
+		"same identifier":            {old: "tf-acc-test", new: "tf-acc-test", expected: true},
+		"ARN and identifier":         {old: snapshotARN, new: "tf-acc-test", expected: true},
+		"identifier and ARN":         {old: "tf-acc-test", new: snapshotARN, expected: true},
+		"automated ARN":              {old: automatedARN, new: "rds:mycluster-2024-01-02", expected: true},
+		"different identifier":       {old: snapshotARN, new: "other", expected: false},
+		"different ARNs":             {old: snapshotARN, new: otherRegionARN, expected: false},
+		"instance snapshot ARN":      {old: otherResourceARN, new: "tf-acc-test", expected: false},
+		"different plain identifier": {old: "tf-acc-test", new: "other", expected: false},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfrds.SuppressEquivalentClusterSnapshotIdentifiers("source_db_cluster_snapshot_identifier", testCase.old, testCase.new, nil); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestAccRDSClusterSnapshotCopy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBClusterSnapshot
+	resourceName := "aws_rds_cluster_snapshot_copy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterSnapshotCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotCopyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotCopyExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfrds.ResourceClusterSnapshotCopy(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccRDSClusterSnapshotCopy_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v rds.DBClusterSnapshot
+	resourceName := "aws_rds_cluster_snapshot_copy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterSnapshotCopyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotCopyConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotCopyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterSnapshotCopyConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotCopyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccClusterSnapshotCopyConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotCopyExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckClusterSnapshotCopyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acctest.Provider.Meta().(*conns.AWSClient)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_rds_cluster_snapshot_copy" {
+				continue
+			}
+
+			conn := tfrds.ClusterSnapshotRegionConn(client, rs.Primary.Attributes["destination_region"])
+
+			_, err := tfrds.FindDBClusterSnapshotByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("RDS DB Cluster Snapshot Copy %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckClusterSnapshotCopyExists(ctx context.Context, n string, v *rds.DBClusterSnapshot) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No RDS DB Cluster Snapshot Copy ID is set")
+		}
+
+		conn := tfrds.ClusterSnapshotRegionConn(acctest.Provider.Meta().(*conns.AWSClient), rs.Primary.Attributes["destination_region"])
+
+		output, err := tfrds.FindDBClusterSnapshotByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccClusterSnapshotCopyImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["destination_region"], rs.Primary.ID), nil
+	}
+}
+
+func testAccClusterSnapshotCopyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterSnapshotConfig_basic(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_snapshot_copy" "test" {
+  source_db_cluster_snapshot_identifier = aws_db_cluster_snapshot.test.db_cluster_snapshot_arn
+  target_db_cluster_snapshot_identifier = "%[1]s-target"
+}
+`, rName))
+}
+
+func testAccClusterSnapshotCopyConfig_destinationRegion(rName string) string {
+	return acctest.ConfigCompose(testAccClusterSnapshotConfig_basic(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_snapshot_copy" "test" {
+  source_db_cluster_snapshot_identifier = aws_db_cluster_snapshot.test.db_cluster_snapshot_arn
+  target_db_cluster_snapshot_identifier = "%[1]s-target"
+  destination_region                    = %[2]q
+}
+`, rName, acctest.AlternateRegion()))
+}
+
+func testAccClusterSnapshotCopyConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccClusterSnapshotConfig_basic(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_snapshot_copy" "test" {
+  source_db_cluster_snapshot_identifier = aws_db_cluster_snapshot.test.db_cluster_snapshot_arn
+  target_db_cluster_snapshot_identifier = "%[1]s-target"
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccClusterSnapshotCopyConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccClusterSnapshotConfig_basic(rName), fmt.Sprintf(`
+resource "aws_rds_cluster_snapshot_copy" "test" {
+  source_db_cluster_snapshot_identifier = aws_db_cluster_snapshot.test.db_cluster_snapshot_arn
+  target_db_cluster_snapshot_identifier = "%[1]s-target"
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -2,18 +2,50 @@ package rds
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	clusterSnapshotMatchedByClusterIdentifier   = "cluster_identifier"
+	clusterSnapshotMatchedByEngineVersion       = "engine_version"
+	clusterSnapshotMatchedByEngineVersionPrefix = "engine_version_prefix"
+	clusterSnapshotMatchedByFilter              = "filter"
+	clusterSnapshotMatchedByIdentifier          = "identifier"
+	clusterSnapshotMatchedByIdentifierPrefix    = "identifier_prefix"
+	clusterSnapshotMatchedBySnapshotType        = "snapshot_type"
+	clusterSnapshotMatchedByTag                 = "tag"
+	clusterSnapshotMatchedByVPCID               = "vpc_id"
+)
+
+const (
+	// Automated snapshots are named after their cluster and creation time, e.g. rds:mycluster-2024-01-01-00-00.
+	clusterSnapshotAutomatedIdentifierPrefix = "rds:"
+)
+
+const (
+	clusterSnapshotSelectLargest    = "largest"
+	clusterSnapshotSelectMostRecent = "most_recent"
 )
 
 func DataSourceClusterSnapshot() *schema.Resource {
@@ -28,10 +60,58 @@ func DataSourceClusterSnapshot() *schema.Resource {
 			},
 
 			"db_cluster_snapshot_identifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"db_cluster_snapshot_identifier_prefix"},
+			},
+
+			"db_cluster_snapshot_identifier_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"db_cluster_snapshot_identifier"},
+			},
+
+			"exclude_snapshot_identifiers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"engine_version_prefix": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
 
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"has_tag_keys": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+
 			"snapshot_type": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -43,16 +123,45 @@ func DataSourceClusterSnapshot() *schema.Resource {
 				Default:  false,
 			},
 
+			"include_automated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"include_public": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
 			"most_recent": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"select"},
+			},
+			"prefer_snapshot_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(SnapshotType_Values(), false),
+			},
+			"select": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice([]string{clusterSnapshotSelectLargest, clusterSnapshotSelectMostRecent}, false),
+				ConflictsWith: []string{"most_recent"},
+			},
+			"require_encrypted": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			// Not "source_region", which is the computed region that the snapshot was copied from.
+			"snapshot_region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
 
 			//Computed values returned
 			"allocated_storage": {
@@ -68,6 +177,18 @@ func DataSourceClusterSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"db_system_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"days_old": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"default_port": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"storage_encrypted": {
 				Type:     schema.TypeBool,
 				Computed: true,
@@ -76,8 +197,25 @@ func DataSourceClusterSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"engine_major_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"engine_mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"engine_version": {
 				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"iam_database_authentication_enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"kms_key_enabled": {
+				Type:     schema.TypeBool,
 				Computed: true,
 			},
 			"kms_key_id": {
@@ -88,6 +226,18 @@ func DataSourceClusterSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"matched_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"multi_az_capable": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"owner_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"port": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -96,6 +246,15 @@ func DataSourceClusterSnapshot() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"source_region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"shared_accounts": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
 			"snapshot_create_time": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -106,6 +265,7 @@ func DataSourceClusterSnapshot() *schema.Resource {
 			},
 			"vpc_id": {
 				Type:     schema.TypeString,
+				Optional: true,
 				Computed: true,
 			},
 			"tags": tftags.TagsSchemaComputed(),
@@ -115,80 +275,191 @@ func DataSourceClusterSnapshot() *schema.Resource {
 
 func dataSourceClusterSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	conn := meta.(*conns.AWSClient).RDSConn()
+	region := meta.(*conns.AWSClient).Region
+	if v, ok := d.GetOk("snapshot_region"); ok {
+		region = v.(string)
+	}
+	conn := clusterSnapshotRegionConn(meta.(*conns.AWSClient), region)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	clusterIdentifier, clusterIdentifierOk := d.GetOk("db_cluster_identifier")
 	snapshotIdentifier, snapshotIdentifierOk := d.GetOk("db_cluster_snapshot_identifier")
 
-	if !clusterIdentifierOk && !snapshotIdentifierOk {
-		return sdkdiag.AppendErrorf(diags, "One of db_cluster_snapshot_identifier or db_cluster_identifier must be assigned")
+	snapshotIdentifierPrefix, snapshotIdentifierPrefixOk := d.GetOk("db_cluster_snapshot_identifier_prefix")
+	filter, filterOk := d.GetOk("filter")
+
+	if !clusterIdentifierOk && !snapshotIdentifierOk && !snapshotIdentifierPrefixOk && !filterOk {
+		return sdkdiag.AppendErrorf(diags, "One of db_cluster_snapshot_identifier, db_cluster_snapshot_identifier_prefix, db_cluster_identifier or filter must be assigned")
 	}
 
 	params := &rds.DescribeDBClusterSnapshotsInput{
 		IncludePublic: aws.Bool(d.Get("include_public").(bool)),
 		IncludeShared: aws.Bool(d.Get("include_shared").(bool)),
 	}
-	if v, ok := d.GetOk("snapshot_type"); ok {
-		params.SnapshotType = aws.String(v.(string))
+	var criteria []string
+	if snapshotIdentifierOk {
+		params.DBClusterSnapshotIdentifier = aws.String(snapshotIdentifier.(string))
+		criteria = append(criteria, clusterSnapshotMatchedByIdentifier)
 	}
 	if clusterIdentifierOk {
 		params.DBClusterIdentifier = aws.String(clusterIdentifier.(string))
+		criteria = append(criteria, clusterSnapshotMatchedByClusterIdentifier)
 	}
-	if snapshotIdentifierOk {
-		params.DBClusterSnapshotIdentifier = aws.String(snapshotIdentifier.(string))
+	if filterOk {
+		params.Filters = namevaluesfilters.New(filter.(*schema.Set)).RDSFilters()
+		criteria = append(criteria, clusterSnapshotMatchedByFilter)
+	}
+	if v, ok := d.GetOk("snapshot_type"); ok {
+		params.SnapshotType = aws.String(v.(string))
+		criteria = append(criteria, clusterSnapshotMatchedBySnapshotType)
 	}
 
+	var filters []func([]*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot
+
+	if snapshotIdentifierPrefixOk {
+		criteria = append(criteria, clusterSnapshotMatchedByIdentifierPrefix)
+		filters = append(filters, func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+			return filterClusterSnapshotsByIdentifierPrefix(snapshots, snapshotIdentifierPrefix.(string))
+		})
+	}
+
+	if clusterSnapshotExcludeAutomated(d) {
+		filters = append(filters, excludeAutomatedClusterSnapshots)
+	}
+
+	if v, ok := d.GetOk("exclude_snapshot_identifiers"); ok && v.(*schema.Set).Len() > 0 {
+		identifiers := flex.ExpandStringValueSet(v.(*schema.Set))
+		filters = append(filters, func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+			return excludeClusterSnapshots(snapshots, identifiers)
+		})
+	}
+
+	if v, ok := d.GetOk("engine_version"); ok {
+		criteria = append(criteria, clusterSnapshotMatchedByEngineVersion)
+		filters = append(filters, func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+			return filterClusterSnapshotsByEngineVersion(snapshots, v.(string))
+		})
+	}
+
+	if v, ok := d.GetOk("engine_version_prefix"); ok {
+		criteria = append(criteria, clusterSnapshotMatchedByEngineVersionPrefix)
+		filters = append(filters, func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+			return filterClusterSnapshotsByEngineVersionPrefix(snapshots, v.(string))
+		})
+	}
+
+	if v, ok := d.GetOk("has_tag_keys"); ok && len(v.([]interface{})) > 0 {
+		keys := flex.ExpandStringValueList(v.([]interface{}))
+		criteria = append(criteria, clusterSnapshotMatchedByTag)
+		filters = append(filters, func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+			return filterClusterSnapshotsByTagKeys(snapshots, keys)
+		})
+	}
+
+	if v, ok := d.GetOk("vpc_id"); ok {
+		criteria = append(criteria, clusterSnapshotMatchedByVPCID)
+		filters = append(filters, func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+			return filterClusterSnapshotsByVPCID(snapshots, v.(string))
+		})
+	}
+
+	// Runs after all other client-side filters, so records whether the selection had more than one snapshot to choose from.
+	var multipleCandidates bool
+	filters = append(filters, func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+		if len(snapshots) > 1 {
+			multipleCandidates = true
+		}
+		return snapshots
+	})
+
+	selection := d.Get("select").(string)
+	if d.Get("most_recent").(bool) {
+		selection = clusterSnapshotSelectMostRecent
+	}
+	reduce := reduceClusterSnapshots(filters, selection, d.Get("prefer_snapshot_type").(string))
+
 	log.Printf("[DEBUG] Reading DB Cluster Snapshot: %s", params)
-	resp, err := conn.DescribeDBClusterSnapshotsWithContext(ctx, params)
+	snapshots, includedShared, err := findClusterSnapshotsWithSharedFallback(ctx, conn, params, reduce)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Snapshot (%s): %s", d.Id(), err)
 	}
 
-	if len(resp.DBClusterSnapshots) < 1 {
+	if includedShared {
+		diags = sdkdiag.AppendWarningf(diags, "RDS Cluster Snapshot (%s) was only found after including shared and public snapshots; set include_shared or include_public to true to avoid the additional lookup", snapshotIdentifier.(string))
+	}
+
+	if len(snapshots) < 1 {
 		return sdkdiag.AppendErrorf(diags, "Your query returned no results. Please change your search criteria and try again.")
 	}
 
-	var snapshot *rds.DBClusterSnapshot
-	if len(resp.DBClusterSnapshots) > 1 {
-		recent := d.Get("most_recent").(bool)
-		log.Printf("[DEBUG] aws_db_cluster_snapshot - multiple results found and `most_recent` is set to: %t", recent)
-		if recent {
-			snapshot = mostRecentClusterSnapshot(resp.DBClusterSnapshots)
-		} else {
-			return sdkdiag.AppendErrorf(diags, "Your query returned more than one result. Please try a more specific search criteria.")
+	// When most_recent or select is set, only the selected snapshot is retained.
+	if len(snapshots) > 1 {
+		return sdkdiag.AppendErrorf(diags, "Your query returned more than one result. Please try a more specific search criteria.")
+	}
+
+	snapshot := snapshots[0]
+
+	if d.Get("require_encrypted").(bool) {
+		if err := validateClusterSnapshotEncrypted(snapshot); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
 		}
-	} else {
-		snapshot = resp.DBClusterSnapshots[0]
 	}
 
 	d.SetId(aws.StringValue(snapshot.DBClusterSnapshotIdentifier))
+	snapshotARN := aws.StringValue(snapshot.DBClusterSnapshotArn)
 	d.Set("allocated_storage", snapshot.AllocatedStorage)
 	if err := d.Set("availability_zones", flex.FlattenStringList(snapshot.AvailabilityZones)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting availability_zones: %s", err)
 	}
 	d.Set("db_cluster_identifier", snapshot.DBClusterIdentifier)
-	d.Set("db_cluster_snapshot_arn", snapshot.DBClusterSnapshotArn)
+	d.Set("db_cluster_snapshot_arn", snapshotARN)
 	d.Set("db_cluster_snapshot_identifier", snapshot.DBClusterSnapshotIdentifier)
+	d.Set("db_system_id", aws.StringValue(snapshot.DBSystemId))
+	d.Set("default_port", clusterEngineDefaultPort(aws.StringValue(snapshot.Engine)))
 	d.Set("engine", snapshot.Engine)
+	d.Set("engine_major_version", clusterSnapshotEngineMajorVersion(aws.StringValue(snapshot.EngineVersion)))
+	d.Set("engine_mode", aws.StringValue(snapshot.EngineMode))
 	d.Set("engine_version", snapshot.EngineVersion)
+	d.Set("iam_database_authentication_enabled", aws.BoolValue(snapshot.IAMDatabaseAuthenticationEnabled))
+	d.Set("kms_key_enabled", clusterSnapshotKMSKeyEnabled(snapshot))
 	d.Set("kms_key_id", snapshot.KmsKeyId)
 	d.Set("license_model", snapshot.LicenseModel)
+	var selectionApplied string
+	if multipleCandidates {
+		selectionApplied = selection
+	}
+	d.Set("matched_by", clusterSnapshotMatchedBy(criteria, selectionApplied))
+	d.Set("multi_az_capable", clusterSnapshotMultiAZCapable(snapshot))
+	d.Set("owner_id", clusterSnapshotOwnerID(snapshot))
 	d.Set("port", snapshot.Port)
+
+	// Only the owner of a snapshot can describe its attributes.
+	var sharedAccounts []string
+	if d.Get("include_shared").(bool) && clusterSnapshotOwnerID(snapshot) == meta.(*conns.AWSClient).AccountID {
+		sharedAccounts, err = findClusterSnapshotSharedAccounts(ctx, conn, aws.StringValue(snapshot.DBClusterSnapshotIdentifier))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RDS Cluster Snapshot (%s) attributes: %s", d.Id(), err)
+		}
+	}
+	d.Set("shared_accounts", sharedAccounts)
 	if snapshot.SnapshotCreateTime != nil {
 		d.Set("snapshot_create_time", snapshot.SnapshotCreateTime.Format(time.RFC3339))
+		d.Set("days_old", clusterSnapshotDaysOld(aws.TimeValue(snapshot.SnapshotCreateTime), time.Now()))
 	}
 	d.Set("snapshot_type", snapshot.SnapshotType)
 	d.Set("source_db_cluster_snapshot_arn", snapshot.SourceDBClusterSnapshotArn)
+	sourceRegion := clusterSnapshotSourceRegion(snapshot)
+	d.Set("source_region", sourceRegion)
+	if sourceRegion != "" && sourceRegion != region {
+		diags = sdkdiag.AppendWarningf(diags, "RDS Cluster Snapshot (%s) was copied from region %s; restoring it in %s creates a cluster in %s, not in the source region", d.Id(), sourceRegion, region, region)
+	}
 	d.Set("status", snapshot.Status)
 	d.Set("storage_encrypted", snapshot.StorageEncrypted)
 	d.Set("vpc_id", snapshot.VpcId)
 
-	tags, err := ListTags(ctx, conn, d.Get("db_cluster_snapshot_arn").(string))
-
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "listing tags for RDS DB Cluster Snapshot (%s): %s", d.Get("db_cluster_snapshot_arn").(string), err)
-	}
+	tags, tagsDiags := listClusterSnapshotTags(ctx, conn, snapshotARN)
+	diags = append(diags, tagsDiags...)
 
 	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting tags: %s", err)
@@ -197,11 +468,338 @@ func dataSourceClusterSnapshotRead(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-type rdsClusterSnapshotSort []*rds.DBClusterSnapshot
+// findClusterSnapshots returns the snapshots matching the input across all pages of results.
+// If reduce is specified, it is applied to the snapshots retained so far after each page is read.
+func findClusterSnapshots(ctx context.Context, conn *rds.RDS, input *rds.DescribeDBClusterSnapshotsInput, reduce func([]*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot) ([]*rds.DBClusterSnapshot, error) {
+	var output []*rds.DBClusterSnapshot
+
+	err := conn.DescribeDBClusterSnapshotsPagesWithContext(ctx, input, func(page *rds.DescribeDBClusterSnapshotsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DBClusterSnapshots {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		if reduce != nil {
+			output = reduce(output)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBClusterSnapshotNotFoundFault) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findClusterSnapshotSharedAccounts returns the IDs of the AWS accounts that the specified snapshot is shared with.
+// The list contains "all" if the snapshot is public.
+func findClusterSnapshotSharedAccounts(ctx context.Context, conn *rds.RDS, id string) ([]string, error) {
+	input := &rds.DescribeDBClusterSnapshotAttributesInput{
+		DBClusterSnapshotIdentifier: aws.String(id),
+	}
+
+	output, err := conn.DescribeDBClusterSnapshotAttributesWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.DBClusterSnapshotAttributesResult == nil {
+		return nil, nil
+	}
+
+	for _, v := range output.DBClusterSnapshotAttributesResult.DBClusterSnapshotAttributes {
+		if v != nil && aws.StringValue(v.AttributeName) == clusterSnapshotAttributeNameRestore {
+			return aws.StringValueSlice(v.AttributeValues), nil
+		}
+	}
+
+	return nil, nil
+}
+
+// listClusterSnapshotTags returns the tags of the specified snapshot.
+// Listing tags may not be permitted where describing snapshots is, so a permissions failure is reported as a warning
+// and no tags are returned rather than failing the read. Other failures are returned as errors.
+func listClusterSnapshotTags(ctx context.Context, conn *rds.RDS, snapshotARN string) (tftags.KeyValueTags, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags, err := ListTags(ctx, conn, snapshotARN)
+
+	if tfawserr.ErrCodeEquals(err, errCodeAccessDenied, errCodeUnauthorizedOperation) {
+		return tftags.New(nil), sdkdiag.AppendWarningf(diags, "listing tags for RDS DB Cluster Snapshot (%s), tags will be empty: %s", snapshotARN, err)
+	}
+
+	if err != nil {
+		return tftags.New(nil), sdkdiag.AppendErrorf(diags, "listing tags for RDS DB Cluster Snapshot (%s): %s", snapshotARN, err)
+	}
+
+	return tags, diags
+}
+
+// findClusterSnapshotsWithSharedFallback returns the snapshots matching the input.
+// If a snapshot identifier is specified and no snapshots are found, the lookup is retried including
+// shared and public snapshots, and true is returned if the retry was needed to find them.
+func findClusterSnapshotsWithSharedFallback(ctx context.Context, conn *rds.RDS, input *rds.DescribeDBClusterSnapshotsInput, reduce func([]*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot) ([]*rds.DBClusterSnapshot, bool, error) {
+	snapshots, err := findClusterSnapshots(ctx, conn, input, reduce)
+
+	if err != nil || len(snapshots) > 0 {
+		return snapshots, false, err
+	}
+
+	if input.DBClusterSnapshotIdentifier == nil || (aws.BoolValue(input.IncludeShared) && aws.BoolValue(input.IncludePublic)) {
+		return snapshots, false, nil
+	}
+
+	fallbackInput := *input
+	fallbackInput.IncludePublic = aws.Bool(true)
+	fallbackInput.IncludeShared = aws.Bool(true)
+
+	log.Printf("[DEBUG] Reading DB Cluster Snapshot including shared and public snapshots: %s", fallbackInput)
+	snapshots, err = findClusterSnapshots(ctx, conn, &fallbackInput, reduce)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	return snapshots, len(snapshots) > 0, nil
+}
+
+// reduceClusterSnapshots returns a function that applies the specified client-side filters to snapshots and,
+// if a selection (most_recent or largest) is specified, retains only the selected snapshot so that paging through
+// all snapshots does not accumulate every result.
+func reduceClusterSnapshots(filters []func([]*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot, selection string, preferredSnapshotType string) func([]*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+	return func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+		for _, filter := range filters {
+			snapshots = filter(snapshots)
+		}
+
+		if len(snapshots) < 2 {
+			return snapshots
+		}
+
+		switch selection {
+		case clusterSnapshotSelectLargest:
+			return []*rds.DBClusterSnapshot{largestClusterSnapshot(snapshots, preferredSnapshotType)}
+		case clusterSnapshotSelectMostRecent:
+			return []*rds.DBClusterSnapshot{mostRecentClusterSnapshot(snapshots, preferredSnapshotType)}
+		}
+
+		return snapshots
+	}
+}
+
+// excludeClusterSnapshots returns the snapshots whose identifiers are not in the specified list.
+func excludeClusterSnapshots(snapshots []*rds.DBClusterSnapshot, identifiers []string) []*rds.DBClusterSnapshot {
+	excluded := make(map[string]struct{}, len(identifiers))
+	for _, v := range identifiers {
+		excluded[v] = struct{}{}
+	}
+
+	return tfslices.Filter(snapshots, func(v *rds.DBClusterSnapshot) bool {
+		_, ok := excluded[aws.StringValue(v.DBClusterSnapshotIdentifier)]
+		return !ok
+	})
+}
+
+// clusterSnapshotExcludeAutomated returns whether automated snapshots are left out of the lookup.
+// Unless include_automated is set, they are left out when a cluster's snapshots are looked up without a snapshot type or identifier.
+func clusterSnapshotExcludeAutomated(d *schema.ResourceData) bool {
+	if d.Get("include_automated").(bool) {
+		return false
+	}
+
+	if _, ok := d.GetOk("db_cluster_identifier"); !ok {
+		return false
+	}
+
+	for _, key := range []string{"db_cluster_snapshot_identifier", "db_cluster_snapshot_identifier_prefix", "snapshot_type"} {
+		if _, ok := d.GetOk(key); ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// excludeAutomatedClusterSnapshots returns the snapshots whose identifiers do not begin with "rds:", i.e. that are not automated snapshots.
+func excludeAutomatedClusterSnapshots(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+	return tfslices.Filter(snapshots, func(v *rds.DBClusterSnapshot) bool {
+		return !strings.HasPrefix(aws.StringValue(v.DBClusterSnapshotIdentifier), clusterSnapshotAutomatedIdentifierPrefix)
+	})
+}
+
+// filterClusterSnapshotsByIdentifierPrefix returns the snapshots whose identifier begins with the specified prefix.
+func filterClusterSnapshotsByIdentifierPrefix(snapshots []*rds.DBClusterSnapshot, prefix string) []*rds.DBClusterSnapshot {
+	return tfslices.Filter(snapshots, func(v *rds.DBClusterSnapshot) bool {
+		return strings.HasPrefix(aws.StringValue(v.DBClusterSnapshotIdentifier), prefix)
+	})
+}
+
+// filterClusterSnapshotsByEngineVersion returns the snapshots whose engine version exactly matches the specified version.
+func filterClusterSnapshotsByEngineVersion(snapshots []*rds.DBClusterSnapshot, version string) []*rds.DBClusterSnapshot {
+	return tfslices.Filter(snapshots, func(v *rds.DBClusterSnapshot) bool {
+		return aws.StringValue(v.EngineVersion) == version
+	})
+}
+
+// filterClusterSnapshotsByEngineVersionPrefix returns the snapshots whose engine version begins with the specified prefix.
+func filterClusterSnapshotsByEngineVersionPrefix(snapshots []*rds.DBClusterSnapshot, prefix string) []*rds.DBClusterSnapshot {
+	return tfslices.Filter(snapshots, func(v *rds.DBClusterSnapshot) bool {
+		return strings.HasPrefix(aws.StringValue(v.EngineVersion), prefix)
+	})
+}
+
+// filterClusterSnapshotsByTagKeys returns the snapshots that have all of the specified tag keys, whatever their values.
+func filterClusterSnapshotsByTagKeys(snapshots []*rds.DBClusterSnapshot, keys []string) []*rds.DBClusterSnapshot {
+	return tfslices.Filter(snapshots, func(v *rds.DBClusterSnapshot) bool {
+		tags := KeyValueTags(v.TagList)
+
+		for _, key := range keys {
+			if !tags.KeyExists(key) {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// filterClusterSnapshotsByVPCID returns the snapshots that can be restored into the specified VPC,
+// i.e. those taken in that VPC and those not associated with any VPC.
+func filterClusterSnapshotsByVPCID(snapshots []*rds.DBClusterSnapshot, vpcID string) []*rds.DBClusterSnapshot {
+	return tfslices.Filter(snapshots, func(v *rds.DBClusterSnapshot) bool {
+		id := aws.StringValue(v.VpcId)
+		return id == "" || id == vpcID
+	})
+}
+
+// clusterEngineDefaultPort returns the default port of the specified cluster engine, or 0 if it is not known.
+func clusterEngineDefaultPort(engine string) int {
+	switch engine {
+	case ClusterEngineAurora, ClusterEngineAuroraMySQL, ClusterEngineMySQL:
+		return 3306
+	case ClusterEngineAuroraPostgreSQL, ClusterEnginePostgres:
+		return 5432
+	default:
+		return 0
+	}
+}
+
+// clusterSnapshotDaysOld returns the number of whole days that have passed between the specified creation time and now.
+// A creation time after now, e.g. due to clock skew, is 0 days old.
+func clusterSnapshotDaysOld(createTime, now time.Time) int {
+	if !now.After(createTime) {
+		return 0
+	}
+
+	return int(now.Sub(createTime) / (24 * time.Hour))
+}
+
+// validateClusterSnapshotEncrypted returns an error if the specified snapshot is not encrypted.
+func validateClusterSnapshotEncrypted(snapshot *rds.DBClusterSnapshot) error {
+	if !aws.BoolValue(snapshot.StorageEncrypted) {
+		return fmt.Errorf("RDS Cluster Snapshot (%s) is not encrypted", aws.StringValue(snapshot.DBClusterSnapshotIdentifier))
+	}
+
+	return nil
+}
+
+// clusterSnapshotEngineMajorVersion returns the major version of the specified engine version, e.g. "15" for "15.4".
+// Versions before 10, such as MySQL 8.0 and PostgreSQL 9.6, have two-part major versions, e.g. "8.0" for "8.0.mysql_aurora.3.02.0".
+func clusterSnapshotEngineMajorVersion(version string) string {
+	parts := strings.SplitN(version, ".", 3)
+
+	if major, err := strconv.Atoi(parts[0]); err == nil && major < 10 && len(parts) > 1 {
+		return parts[0] + "." + parts[1]
+	}
+
+	return parts[0]
+}
+
+// clusterSnapshotMatchedBy describes the selection criteria that matched a snapshot.
+// A snapshot identifier selects the snapshot on its own. Otherwise all of the specified criteria narrowed the results
+// and the selection (most_recent or largest) is added if it chose between more than one snapshot.
+func clusterSnapshotMatchedBy(criteria []string, selectionApplied string) string {
+	if slices.Contains(criteria, clusterSnapshotMatchedByIdentifier) {
+		return clusterSnapshotMatchedByIdentifier
+	}
+
+	if selectionApplied != "" {
+		criteria = append(criteria, selectionApplied)
+	}
+
+	return strings.Join(criteria, ",")
+}
+
+// clusterSnapshotMultiAZCapable returns whether the specified snapshot can be restored in more than one Availability Zone.
+func clusterSnapshotMultiAZCapable(snapshot *rds.DBClusterSnapshot) bool {
+	return len(snapshot.AvailabilityZones) > 1
+}
+
+// clusterSnapshotKMSKeyEnabled returns whether the specified snapshot is encrypted with a KMS key.
+// DescribeDBClusterSnapshots reports no details about the key beyond its identifier.
+func clusterSnapshotKMSKeyEnabled(snapshot *rds.DBClusterSnapshot) bool {
+	return aws.BoolValue(snapshot.StorageEncrypted) && aws.StringValue(snapshot.KmsKeyId) != ""
+}
+
+// clusterSnapshotOwnerID returns the ID of the AWS account that owns the specified snapshot.
+// Shared snapshots are owned by the account that shared them.
+func clusterSnapshotOwnerID(snapshot *rds.DBClusterSnapshot) string {
+	if v, err := arn.Parse(aws.StringValue(snapshot.DBClusterSnapshotArn)); err == nil {
+		return v.AccountID
+	}
+
+	return ""
+}
+
+// clusterSnapshotRegionConn returns an RDS client for the specified region, which may differ from the provider's region.
+func clusterSnapshotRegionConn(client *conns.AWSClient, region string) *rds.RDS {
+	if region == "" || region == client.Region {
+		return client.RDSConn()
+	}
+
+	return rds.New(client.Session, aws.NewConfig().WithRegion(region))
+}
+
+// clusterSnapshotSourceRegion returns the region of the snapshot that the specified snapshot was copied from,
+// or the snapshot's own region if it was not copied.
+func clusterSnapshotSourceRegion(snapshot *rds.DBClusterSnapshot) string {
+	for _, v := range []*string{snapshot.SourceDBClusterSnapshotArn, snapshot.DBClusterSnapshotArn} {
+		if v, err := arn.Parse(aws.StringValue(v)); err == nil {
+			return v.Region
+		}
+	}
+
+	return ""
+}
+
+type rdsClusterSnapshotSort struct {
+	snapshots             []*rds.DBClusterSnapshot
+	preferredSnapshotType string
+}
+
+func (s rdsClusterSnapshotSort) Len() int {
+	return len(s.snapshots)
+}
+
+func (s rdsClusterSnapshotSort) Swap(i, j int) {
+	s.snapshots[i], s.snapshots[j] = s.snapshots[j], s.snapshots[i]
+}
+
+func (s rdsClusterSnapshotSort) Less(i, j int) bool {
+	a := s.snapshots
 
-func (a rdsClusterSnapshotSort) Len() int      { return len(a) }
-func (a rdsClusterSnapshotSort) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
-func (a rdsClusterSnapshotSort) Less(i, j int) bool {
 	// Snapshot creation can be in progress
 	if a[i].SnapshotCreateTime == nil {
 		return true
@@ -210,11 +808,45 @@ func (a rdsClusterSnapshotSort) Less(i, j int) bool {
 		return false
 	}
 
-	return (*a[i].SnapshotCreateTime).Before(*a[j].SnapshotCreateTime)
+	if ti, tj := aws.TimeValue(a[i].SnapshotCreateTime), aws.TimeValue(a[j].SnapshotCreateTime); !ti.Equal(tj) {
+		return ti.Before(tj)
+	}
+
+	// Snapshots of the preferred type sort after (are more recent than) other snapshots created at the same time.
+	if s.preferredSnapshotType != "" {
+		if pi, pj := aws.StringValue(a[i].SnapshotType) == s.preferredSnapshotType, aws.StringValue(a[j].SnapshotType) == s.preferredSnapshotType; pi != pj {
+			return pj
+		}
+	}
+
+	// Break any remaining tie by identifier so that the selected snapshot is stable.
+	return aws.StringValue(a[i].DBClusterSnapshotIdentifier) < aws.StringValue(a[j].DBClusterSnapshotIdentifier)
 }
 
-func mostRecentClusterSnapshot(snapshots []*rds.DBClusterSnapshot) *rds.DBClusterSnapshot {
+func mostRecentClusterSnapshot(snapshots []*rds.DBClusterSnapshot, preferredSnapshotType string) *rds.DBClusterSnapshot {
 	sortedSnapshots := snapshots
-	sort.Sort(rdsClusterSnapshotSort(sortedSnapshots))
+	sort.Sort(rdsClusterSnapshotSort{
+		snapshots:             sortedSnapshots,
+		preferredSnapshotType: preferredSnapshotType,
+	})
 	return sortedSnapshots[len(sortedSnapshots)-1]
 }
+
+// largestClusterSnapshot returns the snapshot with the most allocated storage.
+// Snapshots with the same allocated storage are chosen between as by mostRecentClusterSnapshot.
+func largestClusterSnapshot(snapshots []*rds.DBClusterSnapshot, preferredSnapshotType string) *rds.DBClusterSnapshot {
+	var largest []*rds.DBClusterSnapshot
+	var maxAllocatedStorage int64
+
+	for _, v := range snapshots {
+		switch allocatedStorage := aws.Int64Value(v.AllocatedStorage); {
+		case len(largest) == 0 || allocatedStorage > maxAllocatedStorage:
+			largest = []*rds.DBClusterSnapshot{v}
+			maxAllocatedStorage = allocatedStorage
+		case allocatedStorage == maxAllocatedStorage:
+			largest = append(largest, v)
+		}
+	}
+
+	return mostRecentClusterSnapshot(largest, preferredSnapshotType)
+}
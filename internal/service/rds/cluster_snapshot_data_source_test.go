@@ -1,16 +1,1209 @@
 package rds_test
 
 import (
+	"context"
 	"fmt"
+	"reflect"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/rds"
 	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfrds "github.com/hashicorp/terraform-provider-aws/internal/service/rds"
 )
 
+func TestClusterEngineDefaultPort(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]int{
+		tfrds.ClusterEngineAurora:           3306,
+		tfrds.ClusterEngineAuroraMySQL:      3306,
+		tfrds.ClusterEngineAuroraPostgreSQL: 5432,
+		tfrds.ClusterEngineMySQL:            3306,
+		tfrds.ClusterEnginePostgres:         5432,
+		"neptune":                           0,
+		"":                                  0,
+	}
+
+	for engine, expected := range testCases {
+		if got := tfrds.ClusterEngineDefaultPort(engine); got != expected {
+			t.Errorf("engine %q: got %d, expected %d", engine, got, expected)
+		}
+	}
+}
+
+func TestClusterSnapshotDaysOld(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, time.March, 15, 12, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		createTime time.Time
+		expected   int
+	}{
+		"now": {
+			createTime: now,
+		},
+		"less than a day": {
+			createTime: now.Add(-23 * time.Hour),
+		},
+		"one day": {
+			createTime: now.Add(-24 * time.Hour),
+			expected:   1,
+		},
+		"partial days": {
+			createTime: time.Date(2023, time.March, 1, 18, 30, 0, 0, time.UTC),
+			expected:   13,
+		},
+		"across years": {
+			createTime: time.Date(2022, time.March, 15, 12, 0, 0, 0, time.UTC),
+			expected:   365,
+		},
+		"in the future": {
+			createTime: now.Add(time.Hour),
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfrds.ClusterSnapshotDaysOld(testCase.createTime, now); got != testCase.expected {
+				t.Errorf("got %d, expected %d", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestClusterSnapshotEngineMajorVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"":                        "",
+		"15":                      "15",
+		"15.4":                    "15",
+		"14.10":                   "14",
+		"11.21":                   "11",
+		"9.6.22":                  "9.6",
+		"8.0.mysql_aurora.3.02.0": "8.0",
+		"5.7.mysql_aurora.2.11.2": "5.7",
+		"8":                       "8",
+	}
+
+	for version, expected := range testCases {
+		version, expected := version, expected
+
+		t.Run(version, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfrds.ClusterSnapshotEngineMajorVersion(version); got != expected {
+				t.Errorf("got %q, expected %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestClusterSnapshotKMSKeyEnabled(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		snapshot *rds.DBClusterSnapshot
+		expected bool
+	}{
+		"encrypted": {
+			snapshot: &rds.DBClusterSnapshot{
+				KmsKeyId:         aws.String("arn:aws:kms:us-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"), //lintignore:AWSAT003,AWSAT005
+				StorageEncrypted: aws.Bool(true),
+			},
+			expected: true,
+		},
+		"unencrypted": {
+			snapshot: &rds.DBClusterSnapshot{
+				StorageEncrypted: aws.Bool(false),
+			},
+		},
+		"encrypted without key": {
+			snapshot: &rds.DBClusterSnapshot{
+				StorageEncrypted: aws.Bool(true),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := tfrds.ClusterSnapshotKMSKeyEnabled(testCase.snapshot), testCase.expected; got != expected {
+				t.Errorf("got %t, expected %t", got, expected)
+			}
+		})
+	}
+}
+
+func TestClusterSnapshotMatchedBy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		criteria         []string
+		selectionApplied string
+		expected         string
+	}{
+		"identifier": {
+			criteria: []string{"identifier"},
+			expected: "identifier",
+		},
+		"identifier with other criteria": {
+			criteria:         []string{"identifier", "filter", "tag"},
+			selectionApplied: "most_recent",
+			expected:         "identifier",
+		},
+		"cluster identifier": {
+			criteria: []string{"cluster_identifier"},
+			expected: "cluster_identifier",
+		},
+		"most recent": {
+			criteria:         []string{"cluster_identifier"},
+			selectionApplied: "most_recent",
+			expected:         "cluster_identifier,most_recent",
+		},
+		"largest": {
+			criteria:         []string{"cluster_identifier"},
+			selectionApplied: "largest",
+			expected:         "cluster_identifier,largest",
+		},
+		"tag": {
+			criteria: []string{"filter", "tag"},
+			expected: "filter,tag",
+		},
+		"identifier prefix and engine version": {
+			criteria:         []string{"identifier_prefix", "engine_version"},
+			selectionApplied: "most_recent",
+			expected:         "identifier_prefix,engine_version,most_recent",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfrds.ClusterSnapshotMatchedBy(testCase.criteria, testCase.selectionApplied); got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestClusterSnapshotMultiAZCapable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		snapshot *rds.DBClusterSnapshot
+		expected bool
+	}{
+		"no Availability Zones": {
+			snapshot: &rds.DBClusterSnapshot{},
+		},
+		"single Availability Zone": {
+			snapshot: &rds.DBClusterSnapshot{
+				AvailabilityZones: aws.StringSlice([]string{"us-west-2a"}), //lintignore:AWSAT003
+			},
+		},
+		"multiple Availability Zones": {
+			snapshot: &rds.DBClusterSnapshot{
+				AvailabilityZones: aws.StringSlice([]string{"us-west-2a", "us-west-2b", "us-west-2c"}), //lintignore:AWSAT003
+			},
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := tfrds.ClusterSnapshotMultiAZCapable(testCase.snapshot), testCase.expected; got != expected {
+				t.Errorf("got %t, expected %t", got, expected)
+			}
+		})
+	}
+}
+
+func TestClusterSnapshotSourceRegion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		snapshot *rds.DBClusterSnapshot
+		expected string
+	}{
+		"same region": {
+			snapshot: &rds.DBClusterSnapshot{
+				DBClusterSnapshotArn: aws.String("arn:aws:rds:us-west-2:123456789012:cluster-snapshot:test"), //lintignore:AWSAT003,AWSAT005
+			},
+			expected: "us-west-2", //lintignore:AWSAT003
+		},
+		"cross-region copy": {
+			snapshot: &rds.DBClusterSnapshot{
+				DBClusterSnapshotArn:       aws.String("arn:aws:rds:us-west-2:123456789012:cluster-snapshot:test"),   //lintignore:AWSAT003,AWSAT005
+				SourceDBClusterSnapshotArn: aws.String("arn:aws:rds:us-east-1:123456789012:cluster-snapshot:source"), //lintignore:AWSAT003,AWSAT005
+			},
+			expected: "us-east-1", //lintignore:AWSAT003
+		},
+		"same-region copy": {
+			snapshot: &rds.DBClusterSnapshot{
+				DBClusterSnapshotArn:       aws.String("arn:aws:rds:us-west-2:123456789012:cluster-snapshot:test"),   //lintignore:AWSAT003,AWSAT005
+				SourceDBClusterSnapshotArn: aws.String("arn:aws:rds:us-west-2:123456789012:cluster-snapshot:source"), //lintignore:AWSAT003,AWSAT005
+			},
+			expected: "us-west-2", //lintignore:AWSAT003
+		},
+		"no ARNs": {
+			snapshot: &rds.DBClusterSnapshot{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := tfrds.ClusterSnapshotSourceRegion(testCase.snapshot), testCase.expected; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestExcludeClusterSnapshots(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	snapshots := []*rds.DBClusterSnapshot{
+		{
+			DBClusterSnapshotIdentifier: aws.String("oldest"),
+			SnapshotCreateTime:          aws.Time(now.Add(-2 * time.Hour)),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("older"),
+			SnapshotCreateTime:          aws.Time(now.Add(-1 * time.Hour)),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("newest"),
+			SnapshotCreateTime:          aws.Time(now),
+		},
+	}
+
+	got := tfrds.ExcludeClusterSnapshots(snapshots, []string{"newest", "not-present"})
+
+	if len(got) != 2 {
+		t.Fatalf("expected 2 snapshots, got %d", len(got))
+	}
+
+	for _, v := range got {
+		if aws.StringValue(v.DBClusterSnapshotIdentifier) == "newest" {
+			t.Fatalf("excluded snapshot %q was not removed", "newest")
+		}
+	}
+
+	if got, want := aws.StringValue(tfrds.MostRecentClusterSnapshot(got, "").DBClusterSnapshotIdentifier), "older"; got != want {
+		t.Errorf("most recent snapshot = %q, want %q", got, want)
+	}
+}
+
+func TestExcludeAutomatedClusterSnapshots(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	snapshots := []*rds.DBClusterSnapshot{
+		{
+			DBClusterSnapshotIdentifier: aws.String("manual"),
+			SnapshotCreateTime:          aws.Time(now.Add(-2 * time.Hour)),
+			SnapshotType:                aws.String(tfrds.SnapshotTypeManual),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("rds:mycluster-2024-01-02-00-00"),
+			SnapshotCreateTime:          aws.Time(now),
+			SnapshotType:                aws.String(tfrds.SnapshotTypeAutomated),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("rds-manual"),
+			SnapshotCreateTime:          aws.Time(now.Add(-1 * time.Hour)),
+			SnapshotType:                aws.String(tfrds.SnapshotTypeManual),
+		},
+	}
+
+	var got []string
+	for _, v := range tfrds.ExcludeAutomatedClusterSnapshots(snapshots) {
+		got = append(got, aws.StringValue(v.DBClusterSnapshotIdentifier))
+	}
+
+	if want := []string{"manual", "rds-manual"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	// Applied before the most recent snapshot is selected, so a newer automated snapshot is not selected.
+	reduced := tfrds.ReduceClusterSnapshots([]func([]*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot{
+		tfrds.ExcludeAutomatedClusterSnapshots,
+	}, "most_recent", "")(snapshots)
+
+	if len(reduced) != 1 || aws.StringValue(reduced[0].DBClusterSnapshotIdentifier) != "rds-manual" {
+		t.Errorf("most recent: got %v, want [rds-manual]", reduced)
+	}
+}
+
+func TestClusterSnapshotExcludeAutomated(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw      map[string]interface{}
+		expected bool
+	}{
+		"cluster identifier": {
+			raw:      map[string]interface{}{"db_cluster_identifier": "mycluster"},
+			expected: true,
+		},
+		"include_automated": {
+			raw:      map[string]interface{}{"db_cluster_identifier": "mycluster", "include_automated": true},
+			expected: false,
+		},
+		"snapshot_type": {
+			raw:      map[string]interface{}{"db_cluster_identifier": "mycluster", "snapshot_type": tfrds.SnapshotTypeAutomated},
+			expected: false,
+		},
+		"snapshot identifier": {
+			raw:      map[string]interface{}{"db_cluster_identifier": "mycluster", "db_cluster_snapshot_identifier": "rds:mycluster-2024-01-02-00-00"},
+			expected: false,
+		},
+		"snapshot identifier prefix": {
+			raw:      map[string]interface{}{"db_cluster_identifier": "mycluster", "db_cluster_snapshot_identifier_prefix": "rds:"},
+			expected: false,
+		},
+		"no cluster identifier": {
+			raw:      map[string]interface{}{"db_cluster_snapshot_identifier": "mysnapshot"},
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, tfrds.DataSourceClusterSnapshot().Schema, testCase.raw)
+
+			if got := tfrds.ClusterSnapshotExcludeAutomated(d); got != testCase.expected {
+				t.Errorf("got %t, expected %t", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFilterClusterSnapshotsByEngineVersion(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	snapshots := []*rds.DBClusterSnapshot{
+		{
+			DBClusterSnapshotIdentifier: aws.String("old-15.2"),
+			EngineVersion:               aws.String("15.2"),
+			SnapshotCreateTime:          aws.Time(now.Add(-2 * time.Hour)),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("new-15.2"),
+			EngineVersion:               aws.String("15.2"),
+			SnapshotCreateTime:          aws.Time(now.Add(-1 * time.Hour)),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("15.20"),
+			EngineVersion:               aws.String("15.20"),
+			SnapshotCreateTime:          aws.Time(now.Add(-30 * time.Minute)),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("15.4"),
+			EngineVersion:               aws.String("15.4"),
+			SnapshotCreateTime:          aws.Time(now),
+		},
+	}
+
+	for version, want := range map[string][]string{
+		"15.2":  {"old-15.2", "new-15.2"},
+		"15.4":  {"15.4"},
+		"15":    nil,
+		"14.10": nil,
+	} {
+		var got []string
+		for _, v := range tfrds.FilterClusterSnapshotsByEngineVersion(snapshots, version) {
+			got = append(got, aws.StringValue(v.DBClusterSnapshotIdentifier))
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("version %q: got %v, want %v", version, got, want)
+		}
+	}
+
+	// The version filter is applied before the most recent snapshot is selected.
+	reduce := tfrds.ReduceClusterSnapshots([]func([]*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot{
+		func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+			return tfrds.FilterClusterSnapshotsByEngineVersion(snapshots, "15.2")
+		},
+	}, "most_recent", "")
+
+	got := reduce(append([]*rds.DBClusterSnapshot(nil), snapshots...))
+
+	if len(got) != 1 {
+		t.Fatalf("got %d snapshots, want 1", len(got))
+	}
+
+	if got, want := aws.StringValue(got[0].DBClusterSnapshotIdentifier), "new-15.2"; got != want {
+		t.Errorf("got most recent snapshot %s, want %s", got, want)
+	}
+}
+
+func TestFilterClusterSnapshotsByEngineVersionPrefix(t *testing.T) {
+	t.Parallel()
+
+	snapshots := []*rds.DBClusterSnapshot{
+		{
+			DBClusterSnapshotIdentifier: aws.String("pg14"),
+			EngineVersion:               aws.String("14.7"),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("pg15"),
+			EngineVersion:               aws.String("15.2"),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("pg150"),
+			EngineVersion:               aws.String("150.1"),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("unknown"),
+		},
+	}
+
+	for prefix, want := range map[string][]string{
+		"15.": {"pg15"},
+		"14.": {"pg14"},
+		"13.": nil,
+	} {
+		var got []string
+		for _, v := range tfrds.FilterClusterSnapshotsByEngineVersionPrefix(snapshots, prefix) {
+			got = append(got, aws.StringValue(v.DBClusterSnapshotIdentifier))
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("prefix %q: got %v, want %v", prefix, got, want)
+		}
+	}
+}
+
+func TestFilterClusterSnapshotsByVPCID(t *testing.T) {
+	t.Parallel()
+
+	snapshots := []*rds.DBClusterSnapshot{
+		{
+			DBClusterSnapshotIdentifier: aws.String("vpc1-old"),
+			SnapshotCreateTime:          aws.Time(time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)),
+			VpcId:                       aws.String("vpc-11111111"),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("vpc2-new"),
+			SnapshotCreateTime:          aws.Time(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)),
+			VpcId:                       aws.String("vpc-22222222"),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("classic"),
+			SnapshotCreateTime:          aws.Time(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("vpc1-new"),
+			SnapshotCreateTime:          aws.Time(time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)),
+			VpcId:                       aws.String("vpc-11111111"),
+		},
+	}
+
+	for vpcID, want := range map[string][]string{
+		"vpc-11111111": {"vpc1-old", "classic", "vpc1-new"},
+		"vpc-22222222": {"vpc2-new", "classic"},
+		"vpc-33333333": {"classic"},
+	} {
+		var got []string
+		for _, v := range tfrds.FilterClusterSnapshotsByVPCID(snapshots, vpcID) {
+			got = append(got, aws.StringValue(v.DBClusterSnapshotIdentifier))
+		}
+
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("VPC ID %q: got %v, want %v", vpcID, got, want)
+		}
+	}
+
+	// Combined with most_recent, the most recent snapshot from another VPC is not selected.
+	filters := []func([]*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot{
+		func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+			return tfrds.FilterClusterSnapshotsByVPCID(snapshots, "vpc-11111111")
+		},
+	}
+
+	got := tfrds.ReduceClusterSnapshots(filters, "most_recent", "")(snapshots)
+
+	if len(got) != 1 || aws.StringValue(got[0].DBClusterSnapshotIdentifier) != "vpc1-new" {
+		t.Errorf("most recent: got %v, want [vpc1-new]", got)
+	}
+}
+
+func TestFindClusterSnapshotsWithSharedFallback(t *testing.T) {
+	t.Parallel()
+
+	const snapshotARN = "arn:aws:rds:us-west-2:123456789012:cluster-snapshot:shared" //lintignore:AWSAT003,AWSAT005
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := rds.New(sess)
+	conn.Handlers.Clear()
+
+	var requests []*rds.DescribeDBClusterSnapshotsInput
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		input := r.Params.(*rds.DescribeDBClusterSnapshotsInput)
+		requests = append(requests, input)
+
+		if !aws.BoolValue(input.IncludeShared) {
+			r.Error = awserr.New(rds.ErrCodeDBClusterSnapshotNotFoundFault, "not found", nil)
+			return
+		}
+
+		r.Data.(*rds.DescribeDBClusterSnapshotsOutput).DBClusterSnapshots = []*rds.DBClusterSnapshot{{
+			DBClusterSnapshotArn:        aws.String(snapshotARN),
+			DBClusterSnapshotIdentifier: aws.String(snapshotARN),
+			SnapshotType:                aws.String(tfrds.SnapshotTypeShared),
+		}}
+	})
+
+	input := &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: aws.String(snapshotARN),
+		IncludePublic:               aws.Bool(false),
+		IncludeShared:               aws.Bool(false),
+	}
+
+	snapshots, includedShared, err := tfrds.FindClusterSnapshotsWithSharedFallback(context.Background(), conn, input, nil)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !includedShared {
+		t.Error("expected shared and public snapshots to be included")
+	}
+
+	if got, expected := len(requests), 2; got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+
+	if got, expected := len(snapshots), 1; got != expected {
+		t.Fatalf("got %d snapshots, expected %d", got, expected)
+	}
+
+	if got, expected := aws.StringValue(snapshots[0].DBClusterSnapshotIdentifier), snapshotARN; got != expected {
+		t.Errorf("got snapshot %s, expected %s", got, expected)
+	}
+
+	if aws.BoolValue(input.IncludeShared) {
+		t.Error("expected the original input to be unchanged")
+	}
+}
+
+func TestFindClusterSnapshotSharedAccounts(t *testing.T) {
+	t.Parallel()
+
+	const snapshotID = "tf-acc-test"
+
+	testCases := map[string]struct {
+		attributes []*rds.DBClusterSnapshotAttribute
+		expected   []string
+	}{
+		"not shared": {
+			attributes: []*rds.DBClusterSnapshotAttribute{{
+				AttributeName: aws.String("restore"),
+			}},
+		},
+		"shared": {
+			attributes: []*rds.DBClusterSnapshotAttribute{{
+				AttributeName:   aws.String("restore"),
+				AttributeValues: aws.StringSlice([]string{"111122223333"}),
+			}},
+			expected: []string{"111122223333"},
+		},
+		"other attribute": {
+			attributes: []*rds.DBClusterSnapshotAttribute{{
+				AttributeName:   aws.String("other"),
+				AttributeValues: aws.StringSlice([]string{"value"}),
+			}},
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := rds.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(func(r *request.Request) {
+				if got := aws.StringValue(r.Params.(*rds.DescribeDBClusterSnapshotAttributesInput).DBClusterSnapshotIdentifier); got != snapshotID {
+					t.Errorf("got snapshot %s, expected %s", got, snapshotID)
+				}
+
+				r.Data.(*rds.DescribeDBClusterSnapshotAttributesOutput).DBClusterSnapshotAttributesResult = &rds.DBClusterSnapshotAttributesResult{
+					DBClusterSnapshotAttributes: testCase.attributes,
+					DBClusterSnapshotIdentifier: aws.String(snapshotID),
+				}
+			})
+
+			got, err := tfrds.FindClusterSnapshotSharedAccounts(context.Background(), conn, snapshotID)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 0 || len(testCase.expected) != 0 {
+				if !reflect.DeepEqual(got, testCase.expected) {
+					t.Errorf("got %v, expected %v", got, testCase.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestFindClusterSnapshots_mostRecentAcrossPages(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	pages := map[string]*rds.DescribeDBClusterSnapshotsOutput{
+		"": {
+			DBClusterSnapshots: []*rds.DBClusterSnapshot{
+				{DBClusterSnapshotIdentifier: aws.String("page1-older"), SnapshotCreateTime: aws.Time(now.Add(-3 * time.Hour))},
+				{DBClusterSnapshotIdentifier: aws.String("page1-newer"), SnapshotCreateTime: aws.Time(now.Add(-1 * time.Hour))},
+			},
+			Marker: aws.String("page2"),
+		},
+		"page2": {
+			DBClusterSnapshots: []*rds.DBClusterSnapshot{
+				{DBClusterSnapshotIdentifier: aws.String("page2-newest"), SnapshotCreateTime: aws.Time(now)},
+				{DBClusterSnapshotIdentifier: aws.String("page2-oldest"), SnapshotCreateTime: aws.Time(now.Add(-4 * time.Hour))},
+			},
+			Marker: aws.String("page3"),
+		},
+		"page3": {
+			DBClusterSnapshots: []*rds.DBClusterSnapshot{
+				{DBClusterSnapshotIdentifier: aws.String("page3-older"), SnapshotCreateTime: aws.Time(now.Add(-2 * time.Hour))},
+			},
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	conn := rds.New(sess)
+	conn.Handlers.Clear()
+
+	var requests int
+	conn.Handlers.Send.PushBack(func(r *request.Request) {
+		requests++
+		page := pages[aws.StringValue(r.Params.(*rds.DescribeDBClusterSnapshotsInput).Marker)]
+		output := r.Data.(*rds.DescribeDBClusterSnapshotsOutput)
+		output.DBClusterSnapshots = page.DBClusterSnapshots
+		output.Marker = page.Marker
+	})
+
+	var maxRetained int
+	mostRecent := tfrds.ReduceClusterSnapshots(nil, "most_recent", "")
+	reduce := func(snapshots []*rds.DBClusterSnapshot) []*rds.DBClusterSnapshot {
+		snapshots = mostRecent(snapshots)
+		if len(snapshots) > maxRetained {
+			maxRetained = len(snapshots)
+		}
+		return snapshots
+	}
+
+	snapshots, err := tfrds.FindClusterSnapshots(context.Background(), conn, &rds.DescribeDBClusterSnapshotsInput{}, reduce)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := requests, len(pages); got != expected {
+		t.Errorf("got %d requests, expected %d", got, expected)
+	}
+
+	if got, expected := maxRetained, 1; got != expected {
+		t.Errorf("got %d retained snapshots, expected at most %d", got, expected)
+	}
+
+	if got, expected := len(snapshots), 1; got != expected {
+		t.Fatalf("got %d snapshots, expected %d", got, expected)
+	}
+
+	if got, expected := aws.StringValue(snapshots[0].DBClusterSnapshotIdentifier), "page2-newest"; got != expected {
+		t.Errorf("got snapshot %s, expected %s", got, expected)
+	}
+}
+
+func TestFilterClusterSnapshotsByIdentifierPrefix(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	newSnapshots := func() []*rds.DBClusterSnapshot {
+		return []*rds.DBClusterSnapshot{
+			{
+				DBClusterSnapshotIdentifier: aws.String("rds:mycluster-2024-01-01-00-00"),
+				SnapshotCreateTime:          aws.Time(now.Add(-24 * time.Hour)),
+			},
+			{
+				DBClusterSnapshotIdentifier: aws.String("rds:mycluster-2024-01-02-00-00"),
+				SnapshotCreateTime:          aws.Time(now),
+			},
+			{
+				DBClusterSnapshotIdentifier: aws.String("rds:othercluster-2024-01-03-00-00"),
+				SnapshotCreateTime:          aws.Time(now.Add(24 * time.Hour)),
+			},
+		}
+	}
+
+	t.Run("prefix only", func(t *testing.T) {
+		t.Parallel()
+
+		var got []string
+		for _, v := range tfrds.FilterClusterSnapshotsByIdentifierPrefix(newSnapshots(), "rds:othercluster") {
+			got = append(got, aws.StringValue(v.DBClusterSnapshotIdentifier))
+		}
+
+		if expected := []string{"rds:othercluster-2024-01-03-00-00"}; !reflect.DeepEqual(got, expected) {
+			t.Errorf("got %v, expected %v", got, expected)
+		}
+	})
+
+	t.Run("prefix and most recent", func(t *testing.T) {
+		t.Parallel()
+
+		snapshots := tfrds.FilterClusterSnapshotsByIdentifierPrefix(newSnapshots(), "rds:mycluster")
+
+		if got, expected := len(snapshots), 2; got != expected {
+			t.Fatalf("got %d snapshots, expected %d", got, expected)
+		}
+
+		if got, expected := aws.StringValue(tfrds.MostRecentClusterSnapshot(snapshots, "").DBClusterSnapshotIdentifier), "rds:mycluster-2024-01-02-00-00"; got != expected {
+			t.Errorf("got %s, expected %s", got, expected)
+		}
+	})
+}
+
+func TestListClusterSnapshotTags(t *testing.T) {
+	t.Parallel()
+
+	const snapshotARN = "arn:aws:rds:us-west-2:123456789012:cluster-snapshot:tf-acc-test" //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		send            func(r *request.Request)
+		expectedTags    map[string]string
+		expectedWarning bool
+		expectedError   bool
+	}{
+		"tags": {
+			send: func(r *request.Request) {
+				r.Data.(*rds.ListTagsForResourceOutput).TagList = []*rds.Tag{{
+					Key:   aws.String("Name"),
+					Value: aws.String("tf-acc-test"),
+				}}
+			},
+			expectedTags: map[string]string{"Name": "tf-acc-test"},
+		},
+		"access denied": {
+			send: func(r *request.Request) {
+				r.Error = awserr.New("AccessDenied", "User is not authorized to perform: rds:ListTagsForResource", nil)
+			},
+			expectedTags:    map[string]string{},
+			expectedWarning: true,
+		},
+		"unauthorized operation": {
+			send: func(r *request.Request) {
+				r.Error = awserr.New("UnauthorizedOperation", "You are not authorized to perform this operation.", nil)
+			},
+			expectedTags:    map[string]string{},
+			expectedWarning: true,
+		},
+		"throttling": {
+			send: func(r *request.Request) {
+				r.Error = awserr.New("Throttling", "Rate exceeded", nil)
+			},
+			expectedTags:  map[string]string{},
+			expectedError: true,
+		},
+	}
+
+	sess, err := session.NewSession(nil)
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			conn := rds.New(sess)
+			conn.Handlers.Clear()
+			conn.Handlers.Send.PushBack(testCase.send)
+
+			tags, diags := tfrds.ListClusterSnapshotTags(context.Background(), conn, snapshotARN)
+
+			if got := diags.HasError(); got != testCase.expectedError {
+				t.Fatalf("got error %t, expected %t (diags: %v)", got, testCase.expectedError, diags)
+			}
+
+			if got := len(diags) > 0 && !diags.HasError(); got != testCase.expectedWarning {
+				t.Errorf("got warning %t, expected %t (diags: %v)", got, testCase.expectedWarning, diags)
+			}
+
+			if got := tags.Map(); !reflect.DeepEqual(got, testCase.expectedTags) {
+				t.Errorf("got tags %v, expected %v", got, testCase.expectedTags)
+			}
+		})
+	}
+}
+
+func TestMostRecentClusterSnapshot_preferredSnapshotType(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	newSnapshots := func() []*rds.DBClusterSnapshot {
+		return []*rds.DBClusterSnapshot{
+			{
+				DBClusterSnapshotIdentifier: aws.String("older-manual"),
+				SnapshotCreateTime:          aws.Time(now.Add(-1 * time.Hour)),
+				SnapshotType:                aws.String(tfrds.SnapshotTypeManual),
+			},
+			{
+				DBClusterSnapshotIdentifier: aws.String("manual"),
+				SnapshotCreateTime:          aws.Time(now),
+				SnapshotType:                aws.String(tfrds.SnapshotTypeManual),
+			},
+			{
+				DBClusterSnapshotIdentifier: aws.String("automated"),
+				SnapshotCreateTime:          aws.Time(now),
+				SnapshotType:                aws.String(tfrds.SnapshotTypeAutomated),
+			},
+		}
+	}
+
+	for preferredSnapshotType, want := range map[string]string{
+		tfrds.SnapshotTypeManual:    "manual",
+		tfrds.SnapshotTypeAutomated: "automated",
+	} {
+		if got := aws.StringValue(tfrds.MostRecentClusterSnapshot(newSnapshots(), preferredSnapshotType).DBClusterSnapshotIdentifier); got != want {
+			t.Errorf("preferred snapshot type %q: got %q, want %q", preferredSnapshotType, got, want)
+		}
+	}
+}
+
+func TestMostRecentClusterSnapshot_identicalCreateTimes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	for _, identifiers := range [][]string{
+		{"snapshot-a", "snapshot-b"},
+		{"snapshot-b", "snapshot-a"},
+	} {
+		var snapshots []*rds.DBClusterSnapshot
+		for _, v := range identifiers {
+			snapshots = append(snapshots, &rds.DBClusterSnapshot{
+				DBClusterSnapshotIdentifier: aws.String(v),
+				SnapshotCreateTime:          aws.Time(now),
+			})
+		}
+
+		if got, expected := aws.StringValue(tfrds.MostRecentClusterSnapshot(snapshots, "").DBClusterSnapshotIdentifier), "snapshot-b"; got != expected {
+			t.Errorf("identifiers %v: got %s, expected %s", identifiers, got, expected)
+		}
+	}
+}
+
+func TestMostRecentClusterSnapshot_sharedAcrossAccounts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	snapshots := []*rds.DBClusterSnapshot{
+		{
+			DBClusterSnapshotArn:        aws.String("arn:aws:rds:us-west-2:111111111111:cluster-snapshot:own"), //lintignore:AWSAT003,AWSAT005
+			DBClusterSnapshotIdentifier: aws.String("own"),
+			SnapshotCreateTime:          aws.Time(now.Add(-2 * time.Hour)),
+			SnapshotType:                aws.String(tfrds.SnapshotTypeManual),
+		},
+		{
+			DBClusterSnapshotArn:        aws.String("arn:aws:rds:us-west-2:222222222222:cluster-snapshot:newest"), //lintignore:AWSAT003,AWSAT005
+			DBClusterSnapshotIdentifier: aws.String("arn:aws:rds:us-west-2:222222222222:cluster-snapshot:newest"), //lintignore:AWSAT003,AWSAT005
+			SnapshotCreateTime:          aws.Time(now),
+			SnapshotType:                aws.String(tfrds.SnapshotTypeShared),
+		},
+		{
+			DBClusterSnapshotArn:        aws.String("arn:aws:rds:us-west-2:333333333333:cluster-snapshot:older"), //lintignore:AWSAT003,AWSAT005
+			DBClusterSnapshotIdentifier: aws.String("arn:aws:rds:us-west-2:333333333333:cluster-snapshot:older"), //lintignore:AWSAT003,AWSAT005
+			SnapshotCreateTime:          aws.Time(now.Add(-1 * time.Hour)),
+			SnapshotType:                aws.String(tfrds.SnapshotTypeShared),
+		},
+	}
+
+	snapshot := tfrds.MostRecentClusterSnapshot(snapshots, "")
+
+	if got, expected := aws.StringValue(snapshot.DBClusterSnapshotIdentifier), "arn:aws:rds:us-west-2:222222222222:cluster-snapshot:newest"; got != expected { //lintignore:AWSAT003,AWSAT005
+		t.Errorf("got snapshot %s, expected %s", got, expected)
+	}
+
+	if got, expected := tfrds.ClusterSnapshotOwnerID(snapshot), "222222222222"; got != expected {
+		t.Errorf("got owner %s, expected %s", got, expected)
+	}
+}
+
+func TestLargestClusterSnapshot(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	testCases := map[string]struct {
+		snapshots []*rds.DBClusterSnapshot
+		expected  string
+	}{
+		"largest": {
+			snapshots: []*rds.DBClusterSnapshot{
+				{DBClusterSnapshotIdentifier: aws.String("small"), AllocatedStorage: aws.Int64(10), SnapshotCreateTime: aws.Time(now)},
+				{DBClusterSnapshotIdentifier: aws.String("large"), AllocatedStorage: aws.Int64(100), SnapshotCreateTime: aws.Time(now.Add(-2 * time.Hour))},
+				{DBClusterSnapshotIdentifier: aws.String("medium"), AllocatedStorage: aws.Int64(50), SnapshotCreateTime: aws.Time(now.Add(-1 * time.Hour))},
+			},
+			expected: "large",
+		},
+		"tie broken by create time": {
+			snapshots: []*rds.DBClusterSnapshot{
+				{DBClusterSnapshotIdentifier: aws.String("older"), AllocatedStorage: aws.Int64(100), SnapshotCreateTime: aws.Time(now.Add(-1 * time.Hour))},
+				{DBClusterSnapshotIdentifier: aws.String("newer"), AllocatedStorage: aws.Int64(100), SnapshotCreateTime: aws.Time(now)},
+				{DBClusterSnapshotIdentifier: aws.String("small"), AllocatedStorage: aws.Int64(10), SnapshotCreateTime: aws.Time(now.Add(1 * time.Hour))},
+			},
+			expected: "newer",
+		},
+		"no allocated storage": {
+			snapshots: []*rds.DBClusterSnapshot{
+				{DBClusterSnapshotIdentifier: aws.String("unknown"), SnapshotCreateTime: aws.Time(now)},
+				{DBClusterSnapshotIdentifier: aws.String("known"), AllocatedStorage: aws.Int64(1), SnapshotCreateTime: aws.Time(now.Add(-1 * time.Hour))},
+			},
+			expected: "known",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, expected := aws.StringValue(tfrds.LargestClusterSnapshot(testCase.snapshots, "").DBClusterSnapshotIdentifier), testCase.expected; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
+
+func TestReduceClusterSnapshots_largest(t *testing.T) {
+	t.Parallel()
+
+	reduce := tfrds.ReduceClusterSnapshots(nil, "largest", "")
+
+	// Pages are reduced as they are read, so the largest snapshot so far is retained alongside each new page.
+	var got []*rds.DBClusterSnapshot
+	for _, page := range [][]*rds.DBClusterSnapshot{
+		{
+			{DBClusterSnapshotIdentifier: aws.String("page1-small"), AllocatedStorage: aws.Int64(10)},
+			{DBClusterSnapshotIdentifier: aws.String("page1-large"), AllocatedStorage: aws.Int64(200)},
+		},
+		{
+			{DBClusterSnapshotIdentifier: aws.String("page2-medium"), AllocatedStorage: aws.Int64(100)},
+		},
+	} {
+		got = reduce(append(got, page...))
+	}
+
+	if len(got) != 1 || aws.StringValue(got[0].DBClusterSnapshotIdentifier) != "page1-large" {
+		t.Errorf("got %v, want [page1-large]", got)
+	}
+}
+
+func TestFilterClusterSnapshotsByTagKeys(t *testing.T) {
+	t.Parallel()
+
+	snapshots := []*rds.DBClusterSnapshot{
+		{
+			DBClusterSnapshotIdentifier: aws.String("reviewed"),
+			TagList: []*rds.Tag{
+				{Key: aws.String("reviewed"), Value: aws.String("")},
+			},
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("reviewed-approved"),
+			TagList: []*rds.Tag{
+				{Key: aws.String("approved"), Value: aws.String("false")},
+				{Key: aws.String("reviewed"), Value: aws.String("2023-01-01")},
+			},
+		},
+		{
+			DBClusterSnapshotIdentifier: aws.String("untagged"),
+		},
+	}
+
+	testCases := map[string]struct {
+		keys     []string
+		expected []string
+	}{
+		"no keys": {
+			expected: []string{"reviewed", "reviewed-approved", "untagged"},
+		},
+		"one key": {
+			keys:     []string{"reviewed"},
+			expected: []string{"reviewed", "reviewed-approved"},
+		},
+		"all keys": {
+			keys:     []string{"approved", "reviewed"},
+			expected: []string{"reviewed-approved"},
+		},
+		"no match": {
+			keys: []string{"missing"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, v := range tfrds.FilterClusterSnapshotsByTagKeys(snapshots, testCase.keys) {
+				got = append(got, aws.StringValue(v.DBClusterSnapshotIdentifier))
+			}
+
+			if !reflect.DeepEqual(got, testCase.expected) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestClusterSnapshotRegionConn(t *testing.T) {
+	t.Parallel()
+
+	const (
+		region          = "us-west-2" //lintignore:AWSAT003
+		alternateRegion = "us-east-1" //lintignore:AWSAT003
+	)
+
+	sess, err := session.NewSession(&aws.Config{Region: aws.String(region)})
+	if err != nil {
+		t.Fatalf("creating session: %s", err)
+	}
+
+	client := &conns.AWSClient{
+		Region:  region,
+		Session: sess,
+	}
+
+	for _, v := range []string{"", region} {
+		if got := tfrds.ClusterSnapshotRegionConn(client, v); got != client.RDSConn() {
+			t.Errorf("region %q: expected the provider's RDS client", v)
+		}
+	}
+
+	conn := tfrds.ClusterSnapshotRegionConn(client, alternateRegion)
+
+	if conn == nil {
+		t.Fatal("expected an RDS client")
+	}
+
+	if got := aws.StringValue(conn.Config.Region); got != alternateRegion {
+		t.Errorf("got region %q, expected %q", got, alternateRegion)
+	}
+}
+
+func TestValidateClusterSnapshotEncrypted(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		snapshot    *rds.DBClusterSnapshot
+		expectError bool
+	}{
+		"encrypted": {
+			snapshot: &rds.DBClusterSnapshot{
+				DBClusterSnapshotIdentifier: aws.String("test-snapshot"),
+				StorageEncrypted:            aws.Bool(true),
+			},
+		},
+		"unencrypted": {
+			snapshot: &rds.DBClusterSnapshot{
+				DBClusterSnapshotIdentifier: aws.String("test-snapshot"),
+				StorageEncrypted:            aws.Bool(false),
+			},
+			expectError: true,
+		},
+		"encryption unknown": {
+			snapshot: &rds.DBClusterSnapshot{
+				DBClusterSnapshotIdentifier: aws.String("test-snapshot"),
+			},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfrds.ValidateClusterSnapshotEncrypted(testCase.snapshot)
+
+			if testCase.expectError && err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if !testCase.expectError && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
 func TestAccRDSClusterSnapshotDataSource_dbClusterSnapshotIdentifier(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 	dataSourceName := "data.aws_db_cluster_snapshot.test"
@@ -27,21 +1220,31 @@ func TestAccRDSClusterSnapshotDataSource_dbClusterSnapshotIdentifier(t *testing.
 					testAccCheckClusterSnapshotExistsDataSource(dataSourceName),
 					resource.TestCheckResourceAttrPair(dataSourceName, "allocated_storage", resourceName, "allocated_storage"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "availability_zones.#", resourceName, "availability_zones.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "days_old", "0"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_identifier", resourceName, "db_cluster_identifier"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_arn", resourceName, "db_cluster_snapshot_arn"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_identifier", resourceName, "db_cluster_snapshot_identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "db_system_id", ""),
 					resource.TestCheckResourceAttrPair(dataSourceName, "engine", resourceName, "engine"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "engine_mode", "aws_rds_cluster.test", "engine_mode"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "engine_version", resourceName, "engine_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_database_authentication_enabled", "aws_rds_cluster.test", "iam_database_authentication_enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "kms_key_enabled", "false"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_id", resourceName, "kms_key_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "license_model", resourceName, "license_model"),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "owner_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "port", resourceName, "port"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "snapshot_create_time"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_type", resourceName, "snapshot_type"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "source_db_cluster_snapshot_arn", resourceName, "source_db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "source_region", acctest.Region()),
 					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "storage_encrypted", resourceName, "storage_encrypted"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", resourceName, "vpc_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "tags", resourceName, "tags"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_by", "identifier"),
 				),
 			},
 		},
@@ -67,10 +1270,15 @@ func TestAccRDSClusterSnapshotDataSource_dbClusterIdentifier(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_identifier", resourceName, "db_cluster_identifier"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_arn", resourceName, "db_cluster_snapshot_arn"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_identifier", resourceName, "db_cluster_snapshot_identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "db_system_id", ""),
 					resource.TestCheckResourceAttrPair(dataSourceName, "engine", resourceName, "engine"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "engine_mode", "aws_rds_cluster.test", "engine_mode"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "engine_version", resourceName, "engine_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "iam_database_authentication_enabled", "aws_rds_cluster.test", "iam_database_authentication_enabled"),
+					resource.TestCheckResourceAttr(dataSourceName, "kms_key_enabled", "false"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "kms_key_id", resourceName, "kms_key_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "license_model", resourceName, "license_model"),
+					acctest.CheckResourceAttrAccountID(dataSourceName, "owner_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "port", resourceName, "port"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "snapshot_create_time"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "snapshot_type", resourceName, "snapshot_type"),
@@ -79,6 +1287,33 @@ func TestAccRDSClusterSnapshotDataSource_dbClusterIdentifier(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "storage_encrypted", resourceName, "storage_encrypted"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "vpc_id", resourceName, "vpc_id"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "tags", resourceName, "tags"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_by", "cluster_identifier"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSClusterSnapshotDataSource_filter(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_db_cluster_snapshot.test"
+	resourceName := "aws_db_cluster_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExistsDataSource(dataSourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_arn", resourceName, "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_identifier", resourceName, "db_cluster_snapshot_identifier"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "engine", resourceName, "engine"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_by", "filter"),
 				),
 			},
 		},
@@ -101,8 +1336,71 @@ func TestAccRDSClusterSnapshotDataSource_mostRecent(t *testing.T) {
 					testAccCheckClusterSnapshotExistsDataSource(dataSourceName),
 					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_arn", resourceName, "db_cluster_snapshot_arn"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_identifier", resourceName, "db_cluster_snapshot_identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_by", "cluster_identifier,most_recent"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSClusterSnapshotDataSource_selectLargest(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_db_cluster_snapshot.test"
+	resourceName := "aws_db_cluster_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotDataSourceConfig_selectLargest(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExistsDataSource(dataSourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_arn", resourceName, "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "matched_by", "cluster_identifier,largest"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSClusterSnapshotDataSource_selectAndMostRecent(t *testing.T) {
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterSnapshotDataSourceConfig_selectAndMostRecent,
+				ExpectError: regexp.MustCompile(`"select": conflicts with most_recent`),
+			},
+		},
+	})
+}
+
+func TestAccRDSClusterSnapshotDataSource_requireEncrypted(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_db_cluster_snapshot.test"
+	resourceName := "aws_db_cluster_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotDataSourceConfig_requireEncrypted(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterSnapshotExistsDataSource(dataSourceName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_arn", resourceName, "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "storage_encrypted", "true"),
 				),
 			},
+			{
+				Config:      testAccClusterSnapshotDataSourceConfig_requireEncrypted(rName, false),
+				ExpectError: regexp.MustCompile(`is not encrypted`),
+			},
 		},
 	})
 }
@@ -121,6 +1419,33 @@ func testAccCheckClusterSnapshotExistsDataSource(dataSourceName string) resource
 	}
 }
 
+func TestAccRDSClusterSnapshotDataSource_snapshotRegion(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_db_cluster_snapshot.test"
+	resourceName := "aws_db_cluster_snapshot.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckMultipleRegion(t, 2)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, rds.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterSnapshotDataSourceConfig_snapshotRegion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_arn", resourceName, "db_cluster_snapshot_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "db_cluster_snapshot_identifier", resourceName, "db_cluster_snapshot_identifier"),
+					resource.TestCheckResourceAttr(dataSourceName, "snapshot_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(dataSourceName, "source_region", acctest.AlternateRegion()),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Name", rName),
+				),
+			},
+		},
+	})
+}
+
 func testAccClusterSnapshotDataSourceConfig_clusterSnapshotIdentifier(rName string) string {
 	return acctest.ConfigAvailableAZsNoOptIn() + fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -171,6 +1496,54 @@ data "aws_db_cluster_snapshot" "test" {
 `, rName)
 }
 
+func testAccClusterSnapshotDataSourceConfig_requireEncrypted(rName string, storageEncrypted bool) string {
+	return acctest.ConfigAvailableAZsNoOptIn() + fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "192.168.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = "192.168.${count.index}.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = [aws_subnet.test[0].id, aws_subnet.test[1].id]
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier   = %[1]q
+  db_subnet_group_name = aws_db_subnet_group.test.name
+  master_password      = "barbarbarbar"
+  master_username      = "foo"
+  skip_final_snapshot  = true
+  storage_encrypted    = %[2]t
+}
+
+resource "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier          = aws_rds_cluster.test.id
+  db_cluster_snapshot_identifier = %[1]q
+}
+
+data "aws_db_cluster_snapshot" "test" {
+  db_cluster_snapshot_identifier = aws_db_cluster_snapshot.test.id
+  require_encrypted              = true
+}
+`, rName, storageEncrypted)
+}
+
 func testAccClusterSnapshotDataSourceConfig_clusterIdentifier(rName string) string {
 	return acctest.ConfigAvailableAZsNoOptIn() + fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -221,7 +1594,61 @@ data "aws_db_cluster_snapshot" "test" {
 `, rName)
 }
 
-func testAccClusterSnapshotDataSourceConfig_mostRecent(rName string) string {
+func testAccClusterSnapshotDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigAvailableAZsNoOptIn() + fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "192.168.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  count = 2
+
+  availability_zone = data.aws_availability_zones.available.names[count.index]
+  cidr_block        = "192.168.${count.index}.0/24"
+  vpc_id            = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_db_subnet_group" "test" {
+  name       = %[1]q
+  subnet_ids = [aws_subnet.test[0].id, aws_subnet.test[1].id]
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier   = %[1]q
+  db_subnet_group_name = aws_db_subnet_group.test.name
+  master_password      = "barbarbarbar"
+  master_username      = "foo"
+  skip_final_snapshot  = true
+}
+
+resource "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier          = aws_rds_cluster.test.id
+  db_cluster_snapshot_identifier = %[1]q
+}
+
+data "aws_db_cluster_snapshot" "test" {
+  filter {
+    name   = "db-cluster-id"
+    values = [aws_db_cluster_snapshot.test.db_cluster_identifier]
+  }
+
+  filter {
+    name   = "engine"
+    values = [aws_rds_cluster.test.engine]
+  }
+}
+`, rName)
+}
+
+func testAccClusterSnapshotDataSourceConfig_baseTwoSnapshots(rName string) string {
 	return acctest.ConfigAvailableAZsNoOptIn() + fmt.Sprintf(`
 resource "aws_vpc" "test" {
   cidr_block = "192.168.0.0/16"
@@ -265,10 +1692,65 @@ resource "aws_db_cluster_snapshot" "test" {
   db_cluster_identifier          = aws_db_cluster_snapshot.incorrect.db_cluster_identifier
   db_cluster_snapshot_identifier = %[1]q
 }
+`, rName)
+}
 
+func testAccClusterSnapshotDataSourceConfig_mostRecent(rName string) string {
+	return acctest.ConfigCompose(testAccClusterSnapshotDataSourceConfig_baseTwoSnapshots(rName), `
 data "aws_db_cluster_snapshot" "test" {
   db_cluster_identifier = aws_db_cluster_snapshot.test.db_cluster_identifier
   most_recent           = true
 }
-`, rName)
+`)
+}
+
+// Both snapshots are of the same cluster and have the same allocated storage, so the most recent is selected.
+func testAccClusterSnapshotDataSourceConfig_selectLargest(rName string) string {
+	return acctest.ConfigCompose(testAccClusterSnapshotDataSourceConfig_baseTwoSnapshots(rName), `
+data "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier = aws_db_cluster_snapshot.test.db_cluster_identifier
+  select                = "largest"
+}
+`)
+}
+
+const testAccClusterSnapshotDataSourceConfig_selectAndMostRecent = `
+data "aws_db_cluster_snapshot" "test" {
+  db_cluster_identifier = "test"
+  most_recent           = true
+  select                = "most_recent"
+}
+`
+
+func testAccClusterSnapshotDataSourceConfig_snapshotRegion(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateRegionProvider(), fmt.Sprintf(`
+data "aws_region" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_rds_cluster" "test" {
+  provider = "awsalternate"
+
+  cluster_identifier  = %[1]q
+  master_password     = "barbarbarbar"
+  master_username     = "foo"
+  skip_final_snapshot = true
+}
+
+resource "aws_db_cluster_snapshot" "test" {
+  provider = "awsalternate"
+
+  db_cluster_identifier          = aws_rds_cluster.test.id
+  db_cluster_snapshot_identifier = %[1]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_db_cluster_snapshot" "test" {
+  db_cluster_snapshot_identifier = aws_db_cluster_snapshot.test.id
+  snapshot_region                = data.aws_region.alternate.name
+}
+`, rName))
 }
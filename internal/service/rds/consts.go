@@ -25,6 +25,16 @@ const (
 	ClusterStatusUpgrading                  = "upgrading"
 )
 
+const (
+	ClusterSnapshotStatusAvailable = "available"
+	ClusterSnapshotStatusCopying   = "copying"
+	ClusterSnapshotStatusCreating  = "creating"
+)
+
+const (
+	clusterSnapshotAttributeNameRestore = "restore"
+)
+
 const (
 	storageTypeStandard = "standard"
 	storageTypeGP2      = "gp2"
@@ -202,6 +212,24 @@ func RestoreType_Values() []string {
 	}
 }
 
+const (
+	SnapshotTypeAutomated = "automated"
+	SnapshotTypeAWSBackup = "awsbackup"
+	SnapshotTypeManual    = "manual"
+	SnapshotTypePublic    = "public"
+	SnapshotTypeShared    = "shared"
+)
+
+func SnapshotType_Values() []string {
+	return []string{
+		SnapshotTypeAutomated,
+		SnapshotTypeAWSBackup,
+		SnapshotTypeManual,
+		SnapshotTypePublic,
+		SnapshotTypeShared,
+	}
+}
+
 const (
 	TimeoutActionForceApplyCapacityChange = "ForceApplyCapacityChange"
 	TimeoutActionRollbackCapacityChange   = "RollbackCapacityChange"
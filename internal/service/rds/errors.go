@@ -4,4 +4,6 @@ const (
 	errCodeInvalidParameterValue       = "InvalidParameterValue"
 	errCodeValidationError             = "ValidationError"
 	errCodeInvalidParameterCombination = "InvalidParameterCombination"
+	errCodeAccessDenied                = "AccessDenied"
+	errCodeUnauthorizedOperation       = "UnauthorizedOperation"
 )
@@ -1,3 +1,33 @@
 package rds
 
-var FindDBInstanceByID = findDBInstanceByIDSDKv1
+// Exports for use in tests only.
+var (
+	ClusterEngineDefaultPort                     = clusterEngineDefaultPort
+	ClusterSnapshotCopyParseImportID             = clusterSnapshotCopyParseImportID
+	ClusterSnapshotExcludeAutomated              = clusterSnapshotExcludeAutomated
+	ClusterSnapshotDaysOld                       = clusterSnapshotDaysOld
+	ClusterSnapshotEngineMajorVersion            = clusterSnapshotEngineMajorVersion
+	ClusterSnapshotKMSKeyEnabled                 = clusterSnapshotKMSKeyEnabled
+	ClusterSnapshotMatchedBy                     = clusterSnapshotMatchedBy
+	ClusterSnapshotMultiAZCapable                = clusterSnapshotMultiAZCapable
+	ClusterSnapshotOwnerID                       = clusterSnapshotOwnerID
+	ClusterSnapshotRegionConn                    = clusterSnapshotRegionConn
+	ClusterSnapshotSourceRegion                  = clusterSnapshotSourceRegion
+	ExcludeAutomatedClusterSnapshots             = excludeAutomatedClusterSnapshots
+	ExcludeClusterSnapshots                      = excludeClusterSnapshots
+	FilterClusterSnapshotsByEngineVersion        = filterClusterSnapshotsByEngineVersion
+	FilterClusterSnapshotsByEngineVersionPrefix  = filterClusterSnapshotsByEngineVersionPrefix
+	FilterClusterSnapshotsByIdentifierPrefix     = filterClusterSnapshotsByIdentifierPrefix
+	FilterClusterSnapshotsByTagKeys              = filterClusterSnapshotsByTagKeys
+	FilterClusterSnapshotsByVPCID                = filterClusterSnapshotsByVPCID
+	FindClusterSnapshotSharedAccounts            = findClusterSnapshotSharedAccounts
+	FindClusterSnapshots                         = findClusterSnapshots
+	FindClusterSnapshotsWithSharedFallback       = findClusterSnapshotsWithSharedFallback
+	FindDBInstanceByID                           = findDBInstanceByIDSDKv1
+	LargestClusterSnapshot                       = largestClusterSnapshot
+	ListClusterSnapshotTags                      = listClusterSnapshotTags
+	MostRecentClusterSnapshot                    = mostRecentClusterSnapshot
+	ReduceClusterSnapshots                       = reduceClusterSnapshots
+	SuppressEquivalentClusterSnapshotIdentifiers = suppressEquivalentClusterSnapshotIdentifiers
+	ValidateClusterSnapshotEncrypted             = validateClusterSnapshotEncrypted
+)
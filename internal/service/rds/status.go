@@ -143,6 +143,22 @@ func statusDBProxy(ctx context.Context, conn *rds.RDS, name string) resource.Sta
 	}
 }
 
+func statusDBClusterSnapshot(ctx context.Context, conn *rds.RDS, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDBClusterSnapshotByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
 func statusReservedInstance(ctx context.Context, conn *rds.RDS, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindReservedDBInstanceByID(ctx, conn, id)
@@ -366,6 +366,25 @@ func waitDBProxyUpdated(ctx context.Context, conn *rds.RDS, name string, timeout
 	return nil, err
 }
 
+func waitDBClusterSnapshotCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBClusterSnapshot, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ClusterSnapshotStatusCreating, ClusterSnapshotStatusCopying},
+		Target:     []string{ClusterSnapshotStatusAvailable},
+		Refresh:    statusDBClusterSnapshot(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*rds.DBClusterSnapshot); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitReservedInstanceCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{